@@ -27,14 +27,14 @@ func TestClient_BatchModifyAclEntries_Create(t *testing.T) {
 				Operation: CreateBatchOperation,
 				IP:        String("127.0.0.1"),
 				Subnet:    Int(24),
-				Negated:   CBool(false),
+				Negated:   Bool(false),
 				Comment:   String("ACL Entry 1"),
 			},
 			{
 				Operation: CreateBatchOperation,
 				IP:        String("192.168.0.1"),
 				Subnet:    Int(24),
-				Negated:   CBool(true),
+				Negated:   Bool(true),
 				Comment:   String("ACL Entry 2"),
 			},
 		},
@@ -126,14 +126,14 @@ func TestClient_BatchModifyAclEntries_Delete(t *testing.T) {
 				Operation: CreateBatchOperation,
 				IP:        String("127.0.0.1"),
 				Subnet:    Int(24),
-				Negated:   CBool(false),
+				Negated:   Bool(false),
 				Comment:   String("ACL Entry 1"),
 			},
 			{
 				Operation: CreateBatchOperation,
 				IP:        String("192.168.0.1"),
 				Subnet:    Int(24),
-				Negated:   CBool(true),
+				Negated:   Bool(true),
 				Comment:   String("ACL Entry 2"),
 			},
 		},
@@ -228,14 +228,14 @@ func TestClient_BatchModifyAclEntries_Update(t *testing.T) {
 				Operation: CreateBatchOperation,
 				IP:        String("127.0.0.1"),
 				Subnet:    Int(24),
-				Negated:   CBool(false),
+				Negated:   Bool(false),
 				Comment:   String("ACL Entry 1"),
 			},
 			{
 				Operation: CreateBatchOperation,
 				IP:        String("192.168.0.1"),
 				Subnet:    Int(24),
-				Negated:   CBool(true),
+				Negated:   Bool(true),
 				Comment:   String("ACL Entry 2"),
 			},
 		},
@@ -275,7 +275,7 @@ func TestClient_BatchModifyAclEntries_Update(t *testing.T) {
 				ID:        String(createdACLEntries[0].ID),
 				IP:        String("127.0.0.2"),
 				Subnet:    Int(16),
-				Negated:   CBool(true),
+				Negated:   Bool(true),
 				Comment:   String("Updated ACL Entry 1"),
 			},
 		},
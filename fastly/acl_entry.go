@@ -2,9 +2,11 @@ package fastly
 
 import (
 	"fmt"
+	"net/netip"
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/peterhellberg/link"
@@ -38,13 +40,26 @@ func (s entriesById) Less(i, j int) bool {
 type ListACLEntriesInput struct {
 	ServiceID string
 	ACLID     string
+
+	// Direction is the direction in which to sort results, "ascend" or
+	// "descend".
 	Direction string
-	PerPage   int
-	Page      int
-	Sort      string
+
+	// PerPage is the number of entries to return per page.
+	PerPage int
+
+	// Page is the current page.
+	Page int
+
+	// Sort is the field on which to sort, e.g. "ip" or "created". When
+	// empty, the entries are sorted by ID.
+	Sort string
 }
 
-// ListACLEntries return a list of entries for an ACL
+// ListACLEntries return a list of entries for an ACL. Only the requested page
+// is returned when Page or PerPage is set, use ListAllACLEntries,
+// StreamACLEntries or NewListACLEntriesPaginator to fetch every entry of a
+// large ACL.
 func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -54,9 +69,23 @@ func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 		return nil, ErrMissingACLID
 	}
 
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Direction != "" {
+		ro.Params["direction"] = i.Direction
+	}
+	if i.Page > 0 {
+		ro.Params["page"] = strconv.Itoa(i.Page)
+	}
+	if i.PerPage > 0 {
+		ro.Params["per_page"] = strconv.Itoa(i.PerPage)
+	}
+	if i.Sort != "" {
+		ro.Params["sort"] = i.Sort
+	}
+
 	path := fmt.Sprintf("/service/%s/acl/%s/entries", i.ServiceID, i.ACLID)
 
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -66,11 +95,73 @@ func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 		return nil, err
 	}
 
-	sort.Stable(entriesById(es))
+	if i.Sort == "" {
+		sort.Stable(entriesById(es))
+	}
 
 	return es, nil
 }
 
+// ListAllACLEntries returns every entry of an ACL, following the pages of the
+// listing until the last one. Page is the first page to fetch. When Sort is
+// empty, the entries are sorted by ID.
+func (c *Client) ListAllACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
+	var es []*ACLEntry
+	err := c.StreamACLEntries(i, func(e *ACLEntry) error {
+		es = append(es, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if i.Sort == "" {
+		sort.Stable(entriesById(es))
+	}
+
+	return es, nil
+}
+
+// StreamACLEntries calls fn for each entry of an ACL, in the order returned by
+// the API. Every page of the listing is fetched, starting at Page (the first
+// one by default) with PerPage entries each (100 by default), and the entries
+// are decoded one at a time, so memory use stays bounded for very large ACLs.
+// Iteration stops at the first error returned by fn, which is then returned.
+func (c *Client) StreamACLEntries(i *ListACLEntriesInput, fn func(*ACLEntry) error) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+
+	if i.ACLID == "" {
+		return ErrMissingACLID
+	}
+
+	page := i.Page
+	if page <= 0 {
+		page = 1
+	}
+	perPage := i.PerPage
+	if perPage <= 0 {
+		perPage = 100
+	}
+
+	ro := &RequestOptions{
+		Params: map[string]string{
+			"page":     strconv.Itoa(page),
+			"per_page": strconv.Itoa(perPage),
+		},
+	}
+	if i.Direction != "" {
+		ro.Params["direction"] = i.Direction
+	}
+	if i.Sort != "" {
+		ro.Params["sort"] = i.Sort
+	}
+
+	path := fmt.Sprintf("/service/%s/acl/%s/entries", i.ServiceID, i.ACLID)
+	return streamPages(c, path, ro, fn)
+}
+
 type ListAclEntriesPaginator struct {
 	consumed    bool
 	CurrentPage int
@@ -179,7 +270,9 @@ func (c *Client) listACLEntriesWithPage(i *ListACLEntriesInput, p *ListAclEntrie
 		return nil, err
 	}
 
-	sort.Stable(entriesById(es))
+	if i.Sort == "" {
+		sort.Stable(entriesById(es))
+	}
 
 	return es, nil
 }
@@ -228,9 +321,9 @@ type CreateACLEntryInput struct {
 	IP        string `url:"ip"`
 
 	// Optional fields
-	Subnet  int         `url:"subnet,omitempty"`
-	Negated Compatibool `url:"negated,omitempty"`
-	Comment string      `url:"comment,omitempty"`
+	Subnet  int    `url:"subnet,omitempty"`
+	Negated *bool  `url:"negated,omitempty,int"`
+	Comment string `url:"comment,omitempty"`
 }
 
 // CreateACLEntry creates and returns a new ACL entry.
@@ -311,10 +404,10 @@ type UpdateACLEntryInput struct {
 	ID        string
 
 	// Optional fields
-	IP      *string      `url:"ip,omitempty"`
-	Subnet  *int         `url:"subnet,omitempty"`
-	Negated *Compatibool `url:"negated,omitempty"`
-	Comment *string      `url:"comment,omitempty"`
+	IP      *string `url:"ip,omitempty"`
+	Subnet  *int    `url:"subnet,omitempty"`
+	Negated *bool   `url:"negated,omitempty,int"`
+	Comment *string `url:"comment,omitempty"`
 }
 
 // UpdateACLEntry updates an ACL entry
@@ -358,7 +451,7 @@ type BatchACLEntry struct {
 	ID        *string        `json:"id,omitempty"`
 	IP        *string        `json:"ip,omitempty"`
 	Subnet    *int           `json:"subnet,omitempty"`
-	Negated   *Compatibool   `json:"negated,omitempty"`
+	Negated   *bool          `json:"negated,omitempty"`
 	Comment   *string        `json:"comment,omitempty"`
 }
 
@@ -388,3 +481,219 @@ func (c *Client) BatchModifyACLEntries(i *BatchModifyACLEntriesInput) error {
 
 	return nil
 }
+
+// FindACLEntriesInput is the input parameter to FindACLEntries function.
+type FindACLEntriesInput struct {
+	ServiceID string
+	ACLID     string
+	// IP is the IPv4 or IPv6 address to look up (required).
+	IP string
+}
+
+// FindACLEntries returns the entries of an ACL whose IP and subnet contain the
+// given IP address, which answers whether the address is already matched by
+// the ACL. All pages of entries are fetched and matched client-side. Negated
+// entries are included in the result, so callers should check Negated to tell
+// whether a match excludes the address.
+func (c *Client) FindACLEntries(i *FindACLEntriesInput) ([]*ACLEntry, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ACLID == "" {
+		return nil, ErrMissingACLID
+	}
+
+	if i.IP == "" {
+		return nil, ErrMissingIP
+	}
+
+	ip, err := netip.ParseAddr(i.IP)
+	if err != nil {
+		return nil, ErrInvalidIP
+	}
+	ip = ip.Unmap()
+
+	var matches []*ACLEntry
+	err = c.StreamACLEntries(&ListACLEntriesInput{
+		ServiceID: i.ServiceID,
+		ACLID:     i.ACLID,
+	}, func(e *ACLEntry) error {
+		if e.contains(ip) {
+			matches = append(matches, e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// contains reports whether the entry's IP and subnet contain ip. Entries
+// without a subnet match a single address.
+func (e *ACLEntry) contains(ip netip.Addr) bool {
+	addr, err := netip.ParseAddr(e.IP)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	if addr.BitLen() != ip.BitLen() {
+		return false
+	}
+
+	bits := addr.BitLen()
+	if e.Subnet != nil {
+		bits = *e.Subnet
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return false
+	}
+	return prefix.Contains(ip)
+}
+
+// SyncACLEntry is a desired entry passed to SyncACLEntries.
+type SyncACLEntry struct {
+	// IP is an IPv4 or IPv6 address, optionally in CIDR notation (e.g.
+	// 192.0.2.0/24). A prefix length in IP takes precedence over Subnet.
+	IP      string
+	Subnet  *int
+	Negated bool
+	Comment string
+}
+
+// SyncACLEntriesInput is the input parameter to SyncACLEntries function.
+type SyncACLEntriesInput struct {
+	ServiceID string
+	ACLID     string
+	// Entries is the full desired set of ACL entries.
+	Entries []*SyncACLEntry
+}
+
+// SyncACLEntriesResult summarises the changes made by SyncACLEntries.
+type SyncACLEntriesResult struct {
+	Created int
+	Updated int
+	Deleted int
+}
+
+// SyncACLEntries makes the entries of an ACL match the given desired set.
+// Existing entries are fetched and compared by network prefix; missing
+// entries are created, entries whose negation or comment differ are updated,
+// and entries not in the desired set are deleted. Changes are applied through
+// BatchModifyACLEntries in chunks of BatchModifyMaximumOperations, so a
+// failure part way through can leave the ACL partially synced.
+func (c *Client) SyncACLEntries(i *SyncACLEntriesInput) (*SyncACLEntriesResult, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ACLID == "" {
+		return nil, ErrMissingACLID
+	}
+
+	desired := make(map[netip.Prefix]*SyncACLEntry, len(i.Entries))
+	var order []netip.Prefix
+	for _, e := range i.Entries {
+		prefix, err := e.prefix()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := desired[prefix]; !ok {
+			order = append(order, prefix)
+		}
+		desired[prefix] = e
+	}
+
+	var ops []*BatchACLEntry
+	var result SyncACLEntriesResult
+	seen := make(map[netip.Prefix]bool, len(desired))
+
+	p := c.NewListACLEntriesPaginator(&ListACLEntriesInput{
+		ServiceID: i.ServiceID,
+		ACLID:     i.ACLID,
+	})
+	for p.HasNext() {
+		es, err := p.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range es {
+			prefix, err := (&SyncACLEntry{IP: e.IP, Subnet: e.Subnet}).prefix()
+			want, ok := desired[prefix]
+			if err != nil || !ok || seen[prefix] {
+				ops = append(ops, &BatchACLEntry{
+					Operation: DeleteBatchOperation,
+					ID:        String(e.ID),
+				})
+				result.Deleted++
+				continue
+			}
+			seen[prefix] = true
+			if e.Negated != want.Negated || e.Comment != want.Comment {
+				ops = append(ops, &BatchACLEntry{
+					Operation: UpdateBatchOperation,
+					ID:        String(e.ID),
+					Negated:   Bool(want.Negated),
+					Comment:   String(want.Comment),
+				})
+				result.Updated++
+			}
+		}
+	}
+
+	for _, prefix := range order {
+		if seen[prefix] {
+			continue
+		}
+		want := desired[prefix]
+		ops = append(ops, &BatchACLEntry{
+			Operation: CreateBatchOperation,
+			IP:        String(prefix.Addr().String()),
+			Subnet:    Int(prefix.Bits()),
+			Negated:   Bool(want.Negated),
+			Comment:   String(want.Comment),
+		})
+		result.Created++
+	}
+
+	for _, entries := range chunk(ops, BatchModifyMaximumOperations) {
+		if err := c.BatchModifyACLEntries(&BatchModifyACLEntriesInput{
+			ServiceID: i.ServiceID,
+			ACLID:     i.ACLID,
+			Entries:   entries,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
+
+// prefix returns the masked network prefix described by the entry.
+func (e *SyncACLEntry) prefix() (netip.Prefix, error) {
+	if strings.Contains(e.IP, "/") {
+		prefix, err := netip.ParsePrefix(e.IP)
+		if err != nil {
+			return netip.Prefix{}, ErrInvalidIP
+		}
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(e.IP)
+	if err != nil {
+		return netip.Prefix{}, ErrInvalidIP
+	}
+
+	bits := addr.BitLen()
+	if e.Subnet != nil {
+		bits = *e.Subnet
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return netip.Prefix{}, ErrInvalidIP
+	}
+	return prefix, nil
+}
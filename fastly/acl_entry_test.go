@@ -1,6 +1,11 @@
 package fastly
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -26,7 +31,7 @@ func TestClient_ACLEntries(t *testing.T) {
 			ACLID:     testACL.ID,
 			IP:        "10.0.0.3",
 			Subnet:    8,
-			Negated:   Compatibool(false),
+			Negated:   Bool(false),
 			Comment:   "test entry",
 		})
 	})
@@ -66,6 +71,24 @@ func TestClient_ACLEntries(t *testing.T) {
 		t.Errorf("Bad entries: %v", es)
 	}
 
+	// Stream
+	var streamed []*ACLEntry
+	record(t, fixtureBase+"list2", func(c *Client) {
+		err = c.StreamACLEntries(&ListACLEntriesInput{
+			ServiceID: testService.ID,
+			ACLID:     testACL.ID,
+		}, func(e *ACLEntry) error {
+			streamed = append(streamed, e)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(streamed) != len(es) || streamed[0].ID != es[0].ID {
+		t.Errorf("Bad streamed entries: %v", streamed)
+	}
+
 	// List with paginator
 	var es2 []*ACLEntry
 	var paginator PaginatorACLEntries
@@ -122,7 +145,7 @@ func TestClient_ACLEntries(t *testing.T) {
 			ACLID:     testACL.ID,
 			ID:        e.ID,
 			IP:        String("10.0.0.4"),
-			Negated:   CBool(true),
+			Negated:   Bool(true),
 		})
 	})
 	if err != nil {
@@ -302,3 +325,334 @@ func TestClient_BatchModifyACLEntries_validation(t *testing.T) {
 	}
 
 }
+
+func TestClient_FindACLEntries(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=2&per_page=100>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=2&per_page=100>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"a","ip":"192.0.2.0","subnet":24},{"id":"b","ip":"198.51.100.7"},{"id":"c","ip":"2001:db8::","subnet":32}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":"d","ip":"192.0.2.128","subnet":25,"negated":"1"},{"id":"e","ip":"192.0.2.10"}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		ip   string
+		want []string
+	}{
+		{ip: "192.0.2.200", want: []string{"a", "d"}},
+		{ip: "192.0.2.10", want: []string{"a", "e"}},
+		{ip: "198.51.100.7", want: []string{"b"}},
+		{ip: "198.51.100.8", want: nil},
+		{ip: "2001:db8::1", want: []string{"c"}},
+		{ip: "::ffff:192.0.2.10", want: []string{"a", "e"}},
+	} {
+		es, err := c.FindACLEntries(&FindACLEntriesInput{
+			ServiceID: testServiceID,
+			ACLID:     "acl-id",
+			IP:        tc.ip,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, e := range es {
+			got = append(got, e.ID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.ip, tc.want, got)
+		}
+	}
+}
+
+func TestClient_FindACLEntries_validation(t *testing.T) {
+	var err error
+	_, err = testClient.FindACLEntries(&FindACLEntriesInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.FindACLEntries(&FindACLEntriesInput{
+		ServiceID: "foo",
+		ACLID:     "",
+	})
+	if err != ErrMissingACLID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.FindACLEntries(&FindACLEntriesInput{
+		ServiceID: "foo",
+		ACLID:     "bar",
+	})
+	if err != ErrMissingIP {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.FindACLEntries(&FindACLEntriesInput{
+		ServiceID: "foo",
+		ACLID:     "bar",
+		IP:        "not-an-ip",
+	})
+	if err != ErrInvalidIP {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_SyncACLEntries(t *testing.T) {
+	t.Parallel()
+
+	var batches []BatchModifyACLEntriesInput
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id":"keep","ip":"192.0.2.0","subnet":24},{"id":"comment","ip":"198.51.100.7","comment":"old"},{"id":"stale","ip":"203.0.113.1"},{"id":"dupe","ip":"192.0.2.5","subnet":24}]`)
+		case http.MethodPatch:
+			var b BatchModifyACLEntriesInput
+			if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			batches = append(batches, b)
+			fmt.Fprint(w, `{"status":"ok"}`)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := c.SyncACLEntries(&SyncACLEntriesInput{
+		ServiceID: testServiceID,
+		ACLID:     "acl-id",
+		Entries: []*SyncACLEntry{
+			{IP: "192.0.2.0/24"},
+			{IP: "198.51.100.7", Comment: "new"},
+			{IP: "2001:db8::/32", Negated: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *result != (SyncACLEntriesResult{Created: 1, Updated: 1, Deleted: 2}) {
+		t.Errorf("bad result: %+v", *result)
+	}
+	if len(batches) != 1 {
+		t.Fatalf("expected 1 batch, got %d", len(batches))
+	}
+
+	var got []string
+	for _, e := range batches[0].Entries {
+		switch e.Operation {
+		case CreateBatchOperation:
+			got = append(got, fmt.Sprintf("create %s/%d negated=%t", *e.IP, *e.Subnet, *e.Negated))
+		case UpdateBatchOperation:
+			got = append(got, fmt.Sprintf("update %s comment=%s", *e.ID, *e.Comment))
+		default:
+			got = append(got, fmt.Sprintf("%s %s", e.Operation, *e.ID))
+		}
+	}
+	want := []string{
+		"update comment comment=new",
+		"delete keep",
+		"delete stale",
+		"create 2001:db8::/32 negated=true",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("bad operations:\nwant %v\ngot  %v", want, got)
+	}
+}
+
+func TestClient_SyncACLEntries_validation(t *testing.T) {
+	var err error
+	_, err = testClient.SyncACLEntries(&SyncACLEntriesInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.SyncACLEntries(&SyncACLEntriesInput{
+		ServiceID: "foo",
+		ACLID:     "",
+	})
+	if err != ErrMissingACLID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.SyncACLEntries(&SyncACLEntriesInput{
+		ServiceID: "foo",
+		ACLID:     "bar",
+		Entries:   []*SyncACLEntry{{IP: "192.0.2.0/33"}},
+	})
+	if err != ErrInvalidIP {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListAllACLEntries(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries" || q.Get("sort") != "ip" || q.Get("per_page") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch q.Get("page") {
+		case "1":
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=2&per_page=2>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=3&per_page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"c","ip":"192.0.2.1"},{"id":"a","ip":"192.0.2.2"}]`)
+		case "2":
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=3&per_page=2>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=3&per_page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"b","ip":"192.0.2.3"},{"id":"e","ip":"192.0.2.4"}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":"d","ip":"192.0.2.5"}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := func() *ListACLEntriesInput {
+		return &ListACLEntriesInput{
+			ServiceID: testServiceID,
+			ACLID:     "acl-id",
+			PerPage:   2,
+			Sort:      "ip",
+		}
+	}
+
+	es, err := c.ListAllACLEntries(input())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, e := range es {
+		ids = append(ids, e.ID)
+	}
+	if fmt.Sprint(ids) != "[c a b e d]" {
+		t.Errorf("bad entries: %v", ids)
+	}
+
+	ids = nil
+	errStop := errors.New("stop")
+	err = c.StreamACLEntries(input(), func(e *ACLEntry) error {
+		ids = append(ids, e.ID)
+		if len(ids) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Fatalf("expected the callback error, got %v", err)
+	}
+	if fmt.Sprint(ids) != "[c a b]" {
+		t.Errorf("bad entries: %v", ids)
+	}
+
+	i := input()
+	i.Page = 3
+	es, err = c.ListACLEntries(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 1 || es[0].ID != "d" {
+		t.Errorf("bad page 3: %v", es)
+	}
+}
+
+func TestClient_ListAllACLEntries_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListAllACLEntries(&ListACLEntriesInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListAllACLEntries(&ListACLEntriesInput{
+		ServiceID: "foo",
+		ACLID:     "",
+	})
+	if err != ErrMissingACLID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_StreamACLEntries(t *testing.T) {
+	t.Parallel()
+
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries" || q.Get("per_page") != "100" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		pages = append(pages, q.Get("page"))
+		switch q.Get("page") {
+		case "1":
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=2&per_page=100>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=3&per_page=100>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"a","ip":"192.0.2.1"},{"id":"b","ip":"192.0.2.2"}]`)
+		case "2":
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=3&per_page=100>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/acl/acl-id/entries?page=3&per_page=100>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"c","ip":"192.0.2.3"}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":"d","ip":"192.0.2.4"}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	err = c.StreamACLEntries(&ListACLEntriesInput{
+		ServiceID: testServiceID,
+		ACLID:     "acl-id",
+	}, func(e *ACLEntry) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[a b c d]" {
+		t.Errorf("bad entries: %v", ids)
+	}
+	if fmt.Sprint(pages) != "[1 2 3]" {
+		t.Errorf("bad pages: %v", pages)
+	}
+}
@@ -0,0 +1,178 @@
+package fastly
+
+import (
+	"fmt"
+	"time"
+)
+
+// ApexRedirect redirects requests for apex domains, such as example.com, to
+// the www subdomain without custom VCL.
+type ApexRedirect struct {
+	ID              string     `mapstructure:"id"`
+	ServiceID       string     `mapstructure:"service_id"`
+	ServiceVersion  int        `mapstructure:"version"`
+	StatusCode      int        `mapstructure:"status_code"`
+	Domains         []string   `mapstructure:"domains"`
+	FeatureRevision int        `mapstructure:"feature_revision"`
+	CreatedAt       *time.Time `mapstructure:"created_at"`
+	UpdatedAt       *time.Time `mapstructure:"updated_at"`
+	DeletedAt       *time.Time `mapstructure:"deleted_at"`
+}
+
+// ListApexRedirectsInput is used as input to the ListApexRedirects function.
+type ListApexRedirectsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// ListApexRedirects returns the list of apex redirects for the configuration
+// version.
+func (c *Client) ListApexRedirects(i *ListApexRedirectsInput) ([]*ApexRedirect, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/apex-redirects", i.ServiceID, i.ServiceVersion)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ars []*ApexRedirect
+	if err := decodeBodyMap(resp.Body, &ars); err != nil {
+		return nil, err
+	}
+	return ars, nil
+}
+
+// CreateApexRedirectInput is used as input to the CreateApexRedirect function.
+type CreateApexRedirectInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// StatusCode is the HTTP status code of the redirect: 301, 302, 307 or
+	// 308.
+	StatusCode int `url:"status_code,omitempty"`
+	// Domains are the apex domains to redirect (required).
+	Domains         []string `url:"domains,omitempty,brackets"`
+	FeatureRevision *int     `url:"feature_revision,omitempty"`
+}
+
+// CreateApexRedirect creates a new apex redirect.
+func (c *Client) CreateApexRedirect(i *CreateApexRedirectInput) (*ApexRedirect, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	if len(i.Domains) == 0 {
+		return nil, ErrMissingDomains
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/apex-redirects", i.ServiceID, i.ServiceVersion)
+	resp, err := c.PostForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ar *ApexRedirect
+	if err := decodeBodyMap(resp.Body, &ar); err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+// GetApexRedirectInput is used as input to the GetApexRedirect function.
+type GetApexRedirectInput struct {
+	// ApexRedirectID is the ID of the apex redirect to fetch (required).
+	ApexRedirectID string
+}
+
+// GetApexRedirect gets the apex redirect with the given ID.
+func (c *Client) GetApexRedirect(i *GetApexRedirectInput) (*ApexRedirect, error) {
+	if i.ApexRedirectID == "" {
+		return nil, ErrMissingApexRedirectID
+	}
+
+	path := fmt.Sprintf("/apex-redirects/%s", i.ApexRedirectID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ar *ApexRedirect
+	if err := decodeBodyMap(resp.Body, &ar); err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+// UpdateApexRedirectInput is used as input to the UpdateApexRedirect function.
+type UpdateApexRedirectInput struct {
+	// ApexRedirectID is the ID of the apex redirect to update (required).
+	ApexRedirectID string
+
+	StatusCode      *int     `url:"status_code,omitempty"`
+	Domains         []string `url:"domains,omitempty,brackets"`
+	FeatureRevision *int     `url:"feature_revision,omitempty"`
+}
+
+// UpdateApexRedirect updates a specific apex redirect.
+func (c *Client) UpdateApexRedirect(i *UpdateApexRedirectInput) (*ApexRedirect, error) {
+	if i.ApexRedirectID == "" {
+		return nil, ErrMissingApexRedirectID
+	}
+
+	path := fmt.Sprintf("/apex-redirects/%s", i.ApexRedirectID)
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ar *ApexRedirect
+	if err := decodeBodyMap(resp.Body, &ar); err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+// DeleteApexRedirectInput is the input parameter to DeleteApexRedirect.
+type DeleteApexRedirectInput struct {
+	// ApexRedirectID is the ID of the apex redirect to delete (required).
+	ApexRedirectID string
+}
+
+// DeleteApexRedirect deletes the given apex redirect.
+func (c *Client) DeleteApexRedirect(i *DeleteApexRedirectInput) error {
+	if i.ApexRedirectID == "" {
+		return ErrMissingApexRedirectID
+	}
+
+	path := fmt.Sprintf("/apex-redirects/%s", i.ApexRedirectID)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeBodyMap(resp.Body, &r); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return ErrNotOK
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClient_ApexRedirects(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "apex_redirects/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var ar *ApexRedirect
+	record(t, "apex_redirects/create", func(c *Client) {
+		ar, err = c.CreateApexRedirect(&CreateApexRedirectInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: tv.Number,
+			StatusCode:     301,
+			Domains:        []string{"example.com", "example.net"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "apex_redirects/cleanup", func(c *Client) {
+			c.DeleteApexRedirect(&DeleteApexRedirectInput{
+				ApexRedirectID: ar.ID,
+			})
+		})
+	}()
+
+	if ar.StatusCode != 301 {
+		t.Errorf("bad status code: %d", ar.StatusCode)
+	}
+	if !reflect.DeepEqual(ar.Domains, []string{"example.com", "example.net"}) {
+		t.Errorf("bad domains: %v", ar.Domains)
+	}
+
+	// List
+	var ars []*ApexRedirect
+	record(t, "apex_redirects/list", func(c *Client) {
+		ars, err = c.ListApexRedirects(&ListApexRedirectsInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ars) != 1 || ars[0].ID != ar.ID {
+		t.Errorf("bad apex redirects: %v", ars)
+	}
+
+	// Get
+	var nar *ApexRedirect
+	record(t, "apex_redirects/get", func(c *Client) {
+		nar, err = c.GetApexRedirect(&GetApexRedirectInput{
+			ApexRedirectID: ar.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nar.ServiceVersion != tv.Number {
+		t.Errorf("bad version: %d", nar.ServiceVersion)
+	}
+
+	// Update
+	var uar *ApexRedirect
+	record(t, "apex_redirects/update", func(c *Client) {
+		uar, err = c.UpdateApexRedirect(&UpdateApexRedirectInput{
+			ApexRedirectID: ar.ID,
+			StatusCode:     Int(308),
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uar.StatusCode != 308 {
+		t.Errorf("bad status code: %d", uar.StatusCode)
+	}
+
+	// Delete
+	record(t, "apex_redirects/delete", func(c *Client) {
+		err = c.DeleteApexRedirect(&DeleteApexRedirectInput{
+			ApexRedirectID: ar.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ListApexRedirects_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListApexRedirects(&ListApexRedirectsInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListApexRedirects(&ListApexRedirectsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateApexRedirect_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateApexRedirect(&CreateApexRedirectInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateApexRedirect(&CreateApexRedirectInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateApexRedirect(&CreateApexRedirectInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingDomains {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetApexRedirect_validation(t *testing.T) {
+	_, err := testClient.GetApexRedirect(&GetApexRedirectInput{})
+	if err != ErrMissingApexRedirectID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateApexRedirect_validation(t *testing.T) {
+	_, err := testClient.UpdateApexRedirect(&UpdateApexRedirectInput{})
+	if err != ErrMissingApexRedirectID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteApexRedirect_validation(t *testing.T) {
+	err := testClient.DeleteApexRedirect(&DeleteApexRedirectInput{})
+	if err != ErrMissingApexRedirectID {
+		t.Errorf("bad error: %s", err)
+	}
+}
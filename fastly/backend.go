@@ -95,39 +95,42 @@ type CreateBackendInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	Name                string      `url:"name,omitempty"`
-	Comment             string      `url:"comment,omitempty"`
-	Address             string      `url:"address,omitempty"`
-	Port                *uint       `url:"port,omitempty"`
-	OverrideHost        string      `url:"override_host,omitempty"`
-	ConnectTimeout      *uint       `url:"connect_timeout,omitempty"`
-	MaxConn             *uint       `url:"max_conn,omitempty"`
-	ErrorThreshold      *uint       `url:"error_threshold,omitempty"`
-	FirstByteTimeout    *uint       `url:"first_byte_timeout,omitempty"`
-	BetweenBytesTimeout *uint       `url:"between_bytes_timeout,omitempty"`
-	AutoLoadbalance     Compatibool `url:"auto_loadbalance,omitempty"`
-	Weight              *uint       `url:"weight,omitempty"`
-	RequestCondition    string      `url:"request_condition,omitempty"`
-	HealthCheck         string      `url:"healthcheck,omitempty"`
-	Shield              string      `url:"shield,omitempty"`
-	UseSSL              Compatibool `url:"use_ssl,omitempty"`
+	Name                string `url:"name,omitempty"`
+	Comment             string `url:"comment,omitempty"`
+	Address             string `url:"address,omitempty"`
+	Port                *uint  `url:"port,omitempty"`
+	OverrideHost        string `url:"override_host,omitempty"`
+	ConnectTimeout      *uint  `url:"connect_timeout,omitempty"`
+	MaxConn             *uint  `url:"max_conn,omitempty"`
+	ErrorThreshold      *uint  `url:"error_threshold,omitempty"`
+	FirstByteTimeout    *uint  `url:"first_byte_timeout,omitempty"`
+	BetweenBytesTimeout *uint  `url:"between_bytes_timeout,omitempty"`
+	AutoLoadbalance     *bool  `url:"auto_loadbalance,omitempty,int"`
+	Weight              *uint  `url:"weight,omitempty"`
+	RequestCondition    string `url:"request_condition,omitempty"`
+	HealthCheck         string `url:"healthcheck,omitempty"`
+	Shield              string `url:"shield,omitempty"`
+	UseSSL              *bool  `url:"use_ssl,omitempty,int"`
 	// NOTE: Fastly API sets "ssl_check_cert" to true as its default value
-	// if this parameter is not present in the request.
-	// Removing omitempty from this particular field so that we can still
-	// create a new backend with "ssl_check_cert: false" set.
-	SSLCheckCert    Compatibool `url:"ssl_check_cert"`
-	SSLCACert       string      `url:"ssl_ca_cert,omitempty"`
-	SSLClientCert   string      `url:"ssl_client_cert,omitempty"`
-	SSLClientKey    string      `url:"ssl_client_key,omitempty"`
-	SSLHostname     string      `url:"ssl_hostname,omitempty"`
-	SSLCertHostname string      `url:"ssl_cert_hostname,omitempty"`
-	SSLSNIHostname  string      `url:"ssl_sni_hostname,omitempty"`
-	MinTLSVersion   string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion   string      `url:"max_tls_version,omitempty"`
-	SSLCiphers      string      `url:"ssl_ciphers,omitempty"`
+	// if this parameter is not present in the request. Set it to
+	// Bool(false) to create a backend with "ssl_check_cert: false".
+	SSLCheckCert    *bool  `url:"ssl_check_cert,omitempty,int"`
+	SSLCACert       string `url:"ssl_ca_cert,omitempty"`
+	SSLClientCert   string `url:"ssl_client_cert,omitempty"`
+	SSLClientKey    string `url:"ssl_client_key,omitempty"`
+	SSLHostname     string `url:"ssl_hostname,omitempty"`
+	SSLCertHostname string `url:"ssl_cert_hostname,omitempty"`
+	SSLSNIHostname  string `url:"ssl_sni_hostname,omitempty"`
+	MinTLSVersion   string `url:"min_tls_version,omitempty"`
+	MaxTLSVersion   string `url:"max_tls_version,omitempty"`
+	SSLCiphers      string `url:"ssl_ciphers,omitempty"`
 }
 
 // CreateBackend creates a new Fastly backend.
+//
+// Origins that require mutual TLS are configured with SSLClientCert and
+// SSLClientKey, the PEM encoded client certificate and private key presented
+// to the origin, which must be set together.
 func (c *Client) CreateBackend(i *CreateBackendInput) (*Backend, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -137,6 +140,14 @@ func (c *Client) CreateBackend(i *CreateBackendInput) (*Backend, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.SSLClientCert != "" && i.SSLClientKey == "" {
+		return nil, ErrMissingSSLClientKey
+	}
+
+	if i.SSLClientKey != "" && i.SSLClientCert == "" {
+		return nil, ErrMissingSSLClientCert
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/backend", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -200,35 +211,36 @@ type UpdateBackendInput struct {
 	// Name is the name of the backend to update.
 	Name string
 
-	NewName             *string      `url:"name,omitempty"`
-	Comment             *string      `url:"comment,omitempty"`
-	Address             *string      `url:"address,omitempty"`
-	Port                *uint        `url:"port,omitempty"`
-	OverrideHost        *string      `url:"override_host,omitempty"`
-	ConnectTimeout      *uint        `url:"connect_timeout,omitempty"`
-	MaxConn             *uint        `url:"max_conn,omitempty"`
-	ErrorThreshold      *uint        `url:"error_threshold,omitempty"`
-	FirstByteTimeout    *uint        `url:"first_byte_timeout,omitempty"`
-	BetweenBytesTimeout *uint        `url:"between_bytes_timeout,omitempty"`
-	AutoLoadbalance     *Compatibool `url:"auto_loadbalance,omitempty"`
-	Weight              *uint        `url:"weight,omitempty"`
-	RequestCondition    *string      `url:"request_condition,omitempty"`
-	HealthCheck         *string      `url:"healthcheck,omitempty"`
-	Shield              *string      `url:"shield,omitempty"`
-	UseSSL              *Compatibool `url:"use_ssl,omitempty"`
-	SSLCheckCert        *Compatibool `url:"ssl_check_cert,omitempty"`
-	SSLCACert           *string      `url:"ssl_ca_cert,omitempty"`
-	SSLClientCert       *string      `url:"ssl_client_cert,omitempty"`
-	SSLClientKey        *string      `url:"ssl_client_key,omitempty"`
-	SSLHostname         *string      `url:"ssl_hostname,omitempty"`
-	SSLCertHostname     *string      `url:"ssl_cert_hostname,omitempty"`
-	SSLSNIHostname      *string      `url:"ssl_sni_hostname,omitempty"`
-	MinTLSVersion       *string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion       *string      `url:"max_tls_version,omitempty"`
-	SSLCiphers          string       `url:"ssl_ciphers,omitempty"`
+	NewName             *string `url:"name,omitempty"`
+	Comment             *string `url:"comment,omitempty"`
+	Address             *string `url:"address,omitempty"`
+	Port                *uint   `url:"port,omitempty"`
+	OverrideHost        *string `url:"override_host,omitempty"`
+	ConnectTimeout      *uint   `url:"connect_timeout,omitempty"`
+	MaxConn             *uint   `url:"max_conn,omitempty"`
+	ErrorThreshold      *uint   `url:"error_threshold,omitempty"`
+	FirstByteTimeout    *uint   `url:"first_byte_timeout,omitempty"`
+	BetweenBytesTimeout *uint   `url:"between_bytes_timeout,omitempty"`
+	AutoLoadbalance     *bool   `url:"auto_loadbalance,omitempty,int"`
+	Weight              *uint   `url:"weight,omitempty"`
+	RequestCondition    *string `url:"request_condition,omitempty"`
+	HealthCheck         *string `url:"healthcheck,omitempty"`
+	Shield              *string `url:"shield,omitempty"`
+	UseSSL              *bool   `url:"use_ssl,omitempty,int"`
+	SSLCheckCert        *bool   `url:"ssl_check_cert,omitempty,int"`
+	SSLCACert           *string `url:"ssl_ca_cert,omitempty"`
+	SSLClientCert       *string `url:"ssl_client_cert,omitempty"`
+	SSLClientKey        *string `url:"ssl_client_key,omitempty"`
+	SSLHostname         *string `url:"ssl_hostname,omitempty"`
+	SSLCertHostname     *string `url:"ssl_cert_hostname,omitempty"`
+	SSLSNIHostname      *string `url:"ssl_sni_hostname,omitempty"`
+	MinTLSVersion       *string `url:"min_tls_version,omitempty"`
+	MaxTLSVersion       *string `url:"max_tls_version,omitempty"`
+	SSLCiphers          string  `url:"ssl_ciphers,omitempty"`
 }
 
-// UpdateBackend updates a specific backend.
+// UpdateBackend updates a specific backend. SSLClientCert and SSLClientKey
+// must be updated together so that they always match.
 func (c *Client) UpdateBackend(i *UpdateBackendInput) (*Backend, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -242,6 +254,14 @@ func (c *Client) UpdateBackend(i *UpdateBackendInput) (*Backend, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.SSLClientCert != nil && i.SSLClientKey == nil {
+		return nil, ErrMissingSSLClientKey
+	}
+
+	if i.SSLClientKey != nil && i.SSLClientCert == nil {
+		return nil, ErrMissingSSLClientCert
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -1,5 +1,24 @@
 package fastly
 
+// ToPointer returns a pointer to the value passed in. It works for any type
+// and replaces the type specific helpers such as String and Bool.
+//
+// Optional input fields are pointers so that "leave unchanged" (nil) can be
+// told apart from "set to the zero value": ToPointer("") is sent to the API
+// and clears a field such as UpdateWAFInput.PrefetchCondition.
+func ToPointer[T any](v T) *T {
+	return &v
+}
+
+// ToValue returns the value p points to, or the zero value of T if p is nil.
+func ToValue[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
 // String is a helper that returns a pointer to the string value passed in.
 func String(v string) *string {
 	return &v
@@ -0,0 +1,113 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting the API when the client's
+// circuit breaker is open, see WithCircuitBreaker.
+var ErrCircuitOpen = errors.New("circuit breaker is open: too many consecutive failures")
+
+// circuitBreaker stops a Client from issuing requests after a number of
+// consecutive failures, until a cooldown period has passed.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+
+	threshold int
+	cooldown  time.Duration
+
+	// now allows tests to control the passing of time.
+	now func() time.Time
+}
+
+// newCircuitBreaker returns a closed circuitBreaker.
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow returns ErrCircuitOpen if requests should not be issued. Once the
+// cooldown has passed requests are allowed again; the first failure after
+// that re-opens the circuit while the first success closes it.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures >= b.threshold && b.now().Sub(b.openedAt) < b.cooldown {
+		return ErrCircuitOpen
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of a request. Requests that
+// were cancelled by the caller, or whose context is done, say nothing about
+// the API and are not counted.
+func (b *circuitBreaker) record(req *http.Request, resp *http.Response, err error) {
+	if errors.Is(err, context.Canceled) || req.Context().Err() != nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed(resp, err) {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
+
+// failed reports whether a request failed in a way that indicates the API is
+// unavailable: a transport error, or a 429 or 5xx response.
+func failed(resp *http.Response, err error) bool {
+	if _, ok := err.(*HTTPError); !ok && err != nil {
+		return true
+	}
+	return resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500)
+}
+
+// retryBudget caps the number of retries a Client performs within a window of
+// time, so that retries cannot multiply the load on the API during an outage.
+type retryBudget struct {
+	mu          sync.Mutex
+	max         int
+	window      time.Duration
+	windowStart time.Time
+	used        int
+
+	// now allows tests to control the passing of time.
+	now func() time.Time
+}
+
+// take consumes one retry from the budget, reporting whether one was left.
+func (b *retryBudget) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if now.Sub(b.windowStart) >= b.window {
+		b.windowStart = now
+		b.used = 0
+	}
+	if b.used >= b.max {
+		return false
+	}
+	b.used++
+	return true
+}
@@ -0,0 +1,135 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithCircuitBreaker(2, time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Get("/service", nil); err == nil || err == ErrCircuitOpen {
+			t.Fatalf("expected HTTP error, got: %v", err)
+		}
+	}
+
+	if _, err := c.Get("/service", nil); err != ErrCircuitOpen {
+		t.Fatalf("expected ErrCircuitOpen, got: %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("expected 2 calls, got %d", n)
+	}
+
+	// After the cooldown a request is attempted again.
+	now = now.Add(time.Minute)
+	if _, err := c.Get("/service", nil); err == nil || err == ErrCircuitOpen {
+		t.Fatalf("expected HTTP error, got: %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("expected 3 calls, got %d", n)
+	}
+}
+
+func TestCircuitBreaker_success(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest("GET", "/service", nil)
+	b := newCircuitBreaker(2, time.Minute)
+	b.record(req, &http.Response{StatusCode: 503}, &HTTPError{StatusCode: 503})
+	b.record(req, &http.Response{StatusCode: 404}, &HTTPError{StatusCode: 404})
+	b.record(req, &http.Response{StatusCode: 503}, &HTTPError{StatusCode: 503})
+
+	// A client error resets the count of consecutive failures.
+	if err := b.allow(); err != nil {
+		t.Errorf("expected closed circuit, got: %v", err)
+	}
+}
+
+func TestClient_CircuitBreaker_cancelled(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithCircuitBreaker(1, time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests cancelled by the caller do not open the circuit.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 2; i++ {
+		if _, err := c.Get("/service", &RequestOptions{Context: ctx}); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected the context error, got: %v", err)
+		}
+	}
+
+	resp, err := c.Get("/service", nil)
+	if err != nil {
+		t.Fatalf("expected a closed circuit, got: %v", err)
+	}
+	resp.Body.Close()
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+}
+
+func TestClient_RetryBudget(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithRetryPolicy(RetryPolicy{
+		MaxRetries: 5,
+		MinBackoff: time.Millisecond,
+		Budget:     3,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Get("/service", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if n := atomic.LoadInt32(&calls); n != 4 {
+		t.Errorf("expected 4 calls, got %d", n)
+	}
+
+	// The budget is exhausted, so no more retries happen in this window.
+	if _, err := c.Get("/service", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if n := atomic.LoadInt32(&calls); n != 5 {
+		t.Errorf("expected 5 calls, got %d", n)
+	}
+}
@@ -0,0 +1,32 @@
+package fastly
+
+// BrotliCompressionProduct is the ID of the Brotli compression product.
+//
+// When the product is enabled, objects that gzip configurations (see
+// CreateGzip) mark for compression are compressed with Brotli instead of
+// gzip for clients that accept it. The compression quality is chosen by
+// Fastly and cannot be configured through the API.
+const BrotliCompressionProduct = "brotli_compression"
+
+// BrotliCompressionInput is used as input to the GetBrotliCompression,
+// EnableBrotliCompression and DisableBrotliCompression functions.
+type BrotliCompressionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// GetBrotliCompression returns the Brotli compression product of a service.
+// A 404 HTTPError is returned when the product is not enabled.
+func (c *Client) GetBrotliCompression(i *BrotliCompressionInput) (*ProductEnablement, error) {
+	return c.productEnablementRequest("GET", BrotliCompressionProduct, i.ServiceID)
+}
+
+// EnableBrotliCompression enables Brotli compression on a service.
+func (c *Client) EnableBrotliCompression(i *BrotliCompressionInput) (*ProductEnablement, error) {
+	return c.productEnablementRequest("PUT", BrotliCompressionProduct, i.ServiceID)
+}
+
+// DisableBrotliCompression disables Brotli compression on a service.
+func (c *Client) DisableBrotliCompression(i *BrotliCompressionInput) error {
+	return c.disableProduct(BrotliCompressionProduct, i.ServiceID)
+}
@@ -0,0 +1,75 @@
+package fastly
+
+import "testing"
+
+func TestClient_BrotliCompression(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "brotli_compression/"
+
+	// Enable
+	var err error
+	var pe *ProductEnablement
+	record(t, fixtureBase+"enable", func(c *Client) {
+		pe, err = c.EnableBrotliCompression(&BrotliCompressionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pe.Product.ID != BrotliCompressionProduct || pe.Service.ID != testServiceID {
+		t.Errorf("bad product enablement: %+v", pe)
+	}
+
+	// Get
+	record(t, fixtureBase+"get", func(c *Client) {
+		pe, err = c.GetBrotliCompression(&BrotliCompressionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pe.Product.ID != BrotliCompressionProduct {
+		t.Errorf("bad product: %q", pe.Product.ID)
+	}
+
+	// Disable
+	record(t, fixtureBase+"disable", func(c *Client) {
+		err = c.DisableBrotliCompression(&BrotliCompressionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Get once disabled
+	record(t, fixtureBase+"get_disabled", func(c *Client) {
+		_, err = c.GetBrotliCompression(&BrotliCompressionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if herr, ok := err.(*HTTPError); !ok || !herr.IsNotFound() {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+}
+
+func TestClient_BrotliCompression_validation(t *testing.T) {
+	var err error
+	_, err = testClient.EnableBrotliCompression(&BrotliCompressionInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBrotliCompression(&BrotliCompressionInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DisableBrotliCompression(&BrotliCompressionInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+}
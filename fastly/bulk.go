@@ -0,0 +1,115 @@
+package fastly
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BulkItemError is the error returned by a single item of a Bulk operation.
+type BulkItemError struct {
+	// Index is the index of the item that failed.
+	Index int
+
+	// Err is the error returned for the item.
+	Err error
+}
+
+// BulkError aggregates the errors of the items that failed in a Bulk
+// operation. Items that are not listed succeeded (or were never started
+// because the context was cancelled).
+type BulkError struct {
+	Errors []*BulkItemError
+}
+
+// Error implements the error interface.
+func (e *BulkError) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d bulk operation(s) failed:", len(e.Errors))
+	for _, ie := range e.Errors {
+		fmt.Fprintf(&b, "\n    item %d: %s", ie.Index, ie.Err)
+	}
+	return b.String()
+}
+
+// Bulk calls fn for each of items using at most concurrency concurrent
+// goroutines, e.g. to create many domains or purge many keys with c. Items
+// that fail do not stop the remaining items from being processed; their errors
+// are returned together as a *BulkError, identified by their index in items.
+//
+// Bulk is aware of the API rate limit of c: when the client has observed that
+// no modifying requests are left (see RateLimitRemaining), it stops starting
+// new items until the limit is reset. Cancelling ctx stops new items from
+// being started and is passed on to fn; if no item failed ctx.Err() is
+// returned.
+func Bulk[T any](ctx context.Context, c *Client, items []T, concurrency int, fn func(ctx context.Context, item T) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []*BulkItemError
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, concurrency)
+
+dispatch:
+	for i, item := range items {
+		if err := c.waitRateLimitReset(ctx); err != nil || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := fn(ctx, item); err != nil {
+				mu.Lock()
+				errs = append(errs, &BulkItemError{Index: i, Err: err})
+				mu.Unlock()
+			}
+		}(i, item)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Index < errs[j].Index })
+		return &BulkError{Errors: errs}
+	}
+	return ctx.Err()
+}
+
+// waitRateLimitReset blocks while the client has no modifying requests left,
+// until the rate limit is reset or the context is done.
+func (c *Client) waitRateLimitReset(ctx context.Context) error {
+	if c.RateLimitRemaining() > 0 {
+		return nil
+	}
+
+	d := time.Until(c.RateLimitReset())
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
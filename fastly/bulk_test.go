@@ -0,0 +1,90 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_Bulk(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	var running, maxRunning, calls int32
+	err = Bulk(context.Background(), c, items, 3, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		cur := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if cur <= m || atomic.CompareAndSwapInt32(&maxRunning, m, cur) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		if i%5 == 0 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if calls != 20 {
+		t.Errorf("expected 20 calls, got %d", calls)
+	}
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", maxRunning)
+	}
+
+	berr, ok := err.(*BulkError)
+	if !ok {
+		t.Fatalf("expected *BulkError, got: %v", err)
+	}
+	if len(berr.Errors) != 4 {
+		t.Fatalf("expected 4 errors, got %d", len(berr.Errors))
+	}
+	for i, ie := range berr.Errors {
+		if ie.Index != i*5 {
+			t.Errorf("bad index: %d", ie.Index)
+		}
+	}
+}
+
+func TestClient_Bulk_cancel(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const concurrency = 2
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	err = Bulk(ctx, c, make([]struct{}, 100), concurrency, func(ctx context.Context, _ struct{}) error {
+		if atomic.AddInt32(&calls, 1) == 5 {
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("expected context canceled, got: %v", err)
+	}
+
+	// Items already waiting for a free slot when ctx was cancelled may
+	// still start, but no more than concurrency of them.
+	if calls > 5+concurrency {
+		t.Errorf("expected cancellation to stop the remaining items, got %d calls", calls)
+	}
+}
@@ -0,0 +1,60 @@
+package fastly
+
+import (
+	"sync"
+	"time"
+)
+
+// responseCache holds decoded responses of endpoints whose data rarely
+// changes, such as the public IP list and the list of datacenters, so that
+// repeated calls do not hit the API until the entries expire.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+
+	// now allows tests to control the passing of time.
+	now func() time.Time
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// newResponseCache returns an empty responseCache whose entries are kept for
+// ttl.
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// get returns the cached value for key, calling fetch to populate the entry
+// if it is missing or has expired. Errors are not cached. A nil cache always
+// calls fetch.
+func (rc *responseCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if rc == nil {
+		return fetch()
+	}
+
+	rc.mu.Lock()
+	e, ok := rc.entries[key]
+	rc.mu.Unlock()
+	if ok && rc.now().Before(e.expires) {
+		return e.value, nil
+	}
+
+	v, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	rc.mu.Lock()
+	rc.entries[key] = cacheEntry{value: v, expires: rc.now().Add(rc.ttl)}
+	rc.mu.Unlock()
+
+	return v, nil
+}
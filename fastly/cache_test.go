@@ -0,0 +1,156 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_WithCache(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		switch r.URL.Path {
+		case "/public-ip-list":
+			fmt.Fprint(w, `{"addresses":["23.235.32.0/20","151.101.0.0/16"],"ipv6_addresses":["2a04:4e40::/32"]}`)
+		case "/datacenters":
+			fmt.Fprint(w, `[{"code":"AMS","name":"Amsterdam","group":"Europe","shield":"amsterdam-nl"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithCache(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	c.cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		v4, v6, err := c.AllIPs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(v4) != 2 || len(v6) != 1 {
+			t.Fatalf("bad ips: %v %v", v4, v6)
+		}
+		// Modifying the result must not modify the cached response.
+		v4[0] = "modified"
+
+		dcs, err := c.AllDatacenters()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dcs) != 1 || dcs[0].Code != "AMS" {
+			t.Fatalf("bad datacenters: %v", dcs)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("expected 2 calls, got %d", n)
+	}
+
+	v4, err := c.IPs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v4[0] != "23.235.32.0/20" {
+		t.Errorf("cached response was modified: %v", v4)
+	}
+
+	// Once the ttl has passed the responses are fetched again.
+	now = now.Add(time.Hour)
+	if _, _, err := c.AllIPs(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AllDatacenters(); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 4 {
+		t.Errorf("expected 4 calls, got %d", n)
+	}
+}
+
+func TestResponseCache_errorsNotCached(t *testing.T) {
+	t.Parallel()
+
+	rc := newResponseCache(time.Hour)
+
+	var calls int
+	fetch := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("boom")
+		}
+		return calls, nil
+	}
+
+	if _, err := rc.get("key", fetch); err == nil {
+		t.Fatal("expected error")
+	}
+	v, err := rc.get("key", fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Errorf("expected 2, got %v", v)
+	}
+	if v, _ := rc.get("key", fetch); v != 2 {
+		t.Errorf("expected cached 2, got %v", v)
+	}
+}
+
+func TestClient_WithCache_wafRules(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path != "/waf/rules" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		id := "1010010"
+		if r.URL.Query().Get("filter[publisher][in]") == "owasp" {
+			id = "2029718"
+		}
+		fmt.Fprintf(w, `{"data":[{"id":"%s","type":"waf_rule","attributes":{"modsec_rule_id":%s,"publisher":"fastly"}}],"links":{},"meta":{"record_count":1}}`, id, id)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithCache(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		r, err := c.ListAllWAFRules(&ListAllWAFRulesInput{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Items) != 1 || r.Items[0].ModSecID != 1010010 {
+			t.Fatalf("bad rules: %v", r.Items)
+		}
+		// Modifying the result must not modify the cached response.
+		r.Items[0] = nil
+
+		// Different filters are cached separately.
+		r, err = c.ListAllWAFRules(&ListAllWAFRulesInput{FilterPublishers: []string{"owasp"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Items) != 1 || r.Items[0].ModSecID != 2029718 {
+			t.Fatalf("bad rules: %v", r.Items)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("expected 2 calls, got %d", n)
+	}
+}
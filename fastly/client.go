@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,6 +21,7 @@ import (
 	"github.com/google/jsonapi"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/mitchellh/mapstructure"
+	"github.com/peterhellberg/link"
 )
 
 // APIKeyEnvVar is the name of the environment variable where the Fastly API
@@ -44,6 +46,11 @@ const RealtimeStatsEndpointEnvVar = "FASTLY_RTS_URL"
 // DefaultRealtimeStatsEndpoint is the realtime stats endpoint for Fastly.
 const DefaultRealtimeStatsEndpoint = "https://rt.fastly.com"
 
+// StatsEndpointEnvVar is the name of an environment variable that can be used
+// to change the URL of historical stats and usage requests, which are
+// otherwise sent to the API endpoint.
+const StatsEndpointEnvVar = "FASTLY_STATS_URL"
+
 // ProjectURL is the url for this library.
 var ProjectURL = "github.com/fastly/go-fastly"
 
@@ -67,17 +74,101 @@ type Client struct {
 	// Concurrent modifications have undefined semantics.
 	updateLock sync.Mutex
 
+	// apiKeyLock guards apiKey and replacedAPIKey, which are updated when the
+	// token is refreshed, see WithTokenRefresher.
+	apiKeyLock sync.RWMutex
+
 	// apiKey is the Fastly API key to authenticate requests.
 	apiKey string
 
+	// replacedAPIKey is the API key replaced by the last token refresh.
+	replacedAPIKey string
+
+	// tokenRefresher returns a new API key after a 401 response, see
+	// WithTokenRefresher.
+	tokenRefresher TokenRefresher
+
 	// url is the parsed URL from Address
 	url *url.URL
 
+	// statsEndpoint and realtimeStatsEndpoint override the endpoints of the
+	// stats APIs, see WithStatsEndpoint and WithRealtimeStatsEndpoint.
+	statsEndpoint         string
+	realtimeStatsEndpoint string
+
+	// statsURL is the parsed URL from statsEndpoint, or nil if historical
+	// stats are sent to url.
+	statsURL *url.URL
+
+	// rateLimitLock guards remaining and reset, which are updated by
+	// concurrent requests.
+	rateLimitLock sync.RWMutex
+
 	// remaining is last observed value of http header Fastly-RateLimit-Remaining
 	remaining int
 
 	// reset is last observed value of http header Fastly-RateLimit-Reset
 	reset int64
+
+	// userAgent is the User-Agent header value sent with every request.
+	userAgent string
+
+	// applications are the application identifiers appended to the
+	// User-Agent, see WithApplication.
+	applications []string
+
+	// transport is the base http.Transport set with WithTransport.
+	transport *http.Transport
+
+	// transportOptions are applied to the http.Transport of the HTTPClient
+	// when the client is initialized.
+	transportOptions []func(*http.Transport)
+
+	// retryPolicy controls whether and how failed requests are retried, see
+	// WithRetries and WithRetryPolicy.
+	retryPolicy *RetryPolicy
+
+	// retryBudget caps the number of retries, see RetryPolicy.Budget.
+	retryBudget *retryBudget
+
+	// breaker stops requests after consecutive failures, see
+	// WithCircuitBreaker.
+	breaker *circuitBreaker
+
+	// logger receives diagnostic messages, see WithLogger.
+	logger Logger
+
+	// limiter restricts the rate of requests, see WithRateLimit.
+	limiter *rateLimiter
+
+	// cache holds responses of rarely changing endpoints, see WithCache.
+	cache *responseCache
+
+	// requestIDGenerator returns IDs for modifying requests, see
+	// WithRequestIDGenerator.
+	requestIDGenerator func() string
+
+	// compressMinSize is the size from which request bodies are compressed,
+	// see WithRequestCompression.
+	compressMinSize int64
+
+	// responseMetadata receives the metadata of the last response, see
+	// WithResponseMetadata.
+	responseMetadata *responseMetadataRecorder
+
+	// vclValidator checks custom VCL before it is uploaded, see
+	// WithVCLValidator. vclLint uses LintVCL instead, see WithVCLLint.
+	vclValidator VCLValidator
+	vclLint      bool
+
+	// requestContext and requestTimeout are the defaults for the Context and
+	// Timeout of RequestOptions, see WithContext and WithTimeout.
+	requestContext context.Context
+	requestTimeout time.Duration
+
+	// Instrumentation, if set, is notified about the start and completion of
+	// every request issued by the client.
+	Instrumentation Instrumentation
 }
 
 // RTSClient is the entrypoint to the Fastly's Realtime Stats API.
@@ -100,22 +191,27 @@ func DefaultClient() *Client {
 // endpoint. Because Fastly allows some requests without an API key, this
 // function will not error if the API token is not supplied. Attempts to make a
 // request that requires an API key will return a 403 response.
-func NewClient(key string) (*Client, error) {
+func NewClient(key string, opts ...ClientOption) (*Client, error) {
 	endpoint, ok := os.LookupEnv(EndpointEnvVar)
 
 	if !ok {
 		endpoint = DefaultEndpoint
 	}
 
-	return NewClientForEndpoint(key, endpoint)
+	return NewClientForEndpoint(key, endpoint, opts...)
 }
 
 // NewClientForEndpoint creates a new API client with the given key and API
 // endpoint. Because Fastly allows some requests without an API key, this
 // function will not error if the API token is not supplied. Attempts to make a
 // request that requires an API key will return a 403 response.
-func NewClientForEndpoint(key string, endpoint string) (*Client, error) {
+//
+// It is equivalent to calling NewClient with the WithEndpoint option.
+func NewClientForEndpoint(key string, endpoint string, opts ...ClientOption) (*Client, error) {
 	client := &Client{apiKey: key, Address: endpoint}
+	for _, opt := range opts {
+		opt(client)
+	}
 	return client.init()
 }
 
@@ -139,14 +235,35 @@ func NewRealtimeStatsClient() *RTSClient {
 // NewRealtimeStatsClientForEndpoint creates an RTSClient from a token and endpoint url.
 // `token` is a Fastly API token and `endpoint` is RealtimeStatsEndpoint for the production
 // realtime stats API.
-func NewRealtimeStatsClientForEndpoint(token, endpoint string) (*RTSClient, error) {
-	c, err := NewClientForEndpoint(token, endpoint)
+func NewRealtimeStatsClientForEndpoint(token, endpoint string, opts ...ClientOption) (*RTSClient, error) {
+	c, err := NewClientForEndpoint(token, endpoint, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &RTSClient{client: c}, nil
 }
 
+// RealtimeStats returns an RTSClient that shares the client's configuration,
+// such as its API token, HTTPClient and options, but sends requests to the
+// realtime stats endpoint. The endpoint is the one set with
+// WithRealtimeStatsEndpoint, else the RealtimeStatsEndpointEnvVar environment
+// variable, else DefaultRealtimeStatsEndpoint.
+func (c *Client) RealtimeStats() *RTSClient {
+	endpoint := c.realtimeStatsEndpoint
+	if endpoint == "" {
+		var ok bool
+		if endpoint, ok = os.LookupEnv(RealtimeStatsEndpointEnvVar); !ok {
+			endpoint = DefaultRealtimeStatsEndpoint
+		}
+	}
+
+	n := c.clone()
+	n.Address = endpoint
+	// The endpoint was validated by init, or is the default.
+	n.url, _ = url.Parse(endpoint)
+	return &RTSClient{client: n}
+}
+
 func (c *Client) init() (*Client, error) {
 	// Until we do a request, we don't know how many are left.
 	// Use the default limit as a first guess:
@@ -159,22 +276,153 @@ func (c *Client) init() (*Client, error) {
 	}
 	c.url = u
 
+	if c.statsEndpoint == "" {
+		c.statsEndpoint = os.Getenv(StatsEndpointEnvVar)
+	}
+	if c.statsEndpoint != "" {
+		u, err := url.Parse(c.statsEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		c.statsURL = u
+	}
+	if c.realtimeStatsEndpoint != "" {
+		if _, err := url.Parse(c.realtimeStatsEndpoint); err != nil {
+			return nil, err
+		}
+	}
+
 	if c.HTTPClient == nil {
 		c.HTTPClient = cleanhttp.DefaultClient()
 	}
 
+	if c.transport != nil || len(c.transportOptions) > 0 {
+		t := c.transport
+		if t == nil {
+			if ht, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+				t = ht.Clone()
+			} else {
+				t = cleanhttp.DefaultTransport()
+			}
+		}
+		for _, fn := range c.transportOptions {
+			fn(t)
+		}
+
+		// Copy the HTTPClient so that a client shared with other code is not
+		// modified.
+		hc := *c.HTTPClient
+		hc.Transport = t
+		c.HTTPClient = &hc
+	}
+
+	if c.userAgent == "" {
+		c.userAgent = UserAgent
+	}
+	if len(c.applications) > 0 {
+		c.userAgent = fmt.Sprintf("%s %s", c.userAgent, strings.Join(c.applications, " "))
+	}
+
 	return c, nil
 }
 
+// getUserAgent returns the User-Agent header value for the client, falling
+// back to the package-level UserAgent for clients not created via init.
+func (c *Client) getUserAgent() string {
+	if c.userAgent == "" {
+		return UserAgent
+	}
+	return c.userAgent
+}
+
+// WithToken returns a copy of the client that authenticates with the given API
+// token, which avoids constructing a new Client for every token when managing
+// many Fastly accounts. The copy shares the HTTPClient (and therefore its
+// connection pool) and all other configuration with the original client, but
+// tracks its own rate limit information and serializes its own modifying
+// requests. The copy does not refresh its token, see WithTokenRefresher.
+func (c *Client) WithToken(token string) *Client {
+	n := c.clone()
+	n.apiKey = token
+	n.tokenRefresher = nil
+	return n
+}
+
+// WithContext returns a copy of the client whose requests use ctx unless
+// their RequestOptions set a Context, so that typed calls can be cancelled or
+// given a deadline, e.g.
+//
+//	service, err := client.WithContext(ctx).GetService(i)
+//
+// Like WithToken, the copy shares the HTTPClient and all other configuration
+// with the original client.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	n := c.clone()
+	n.requestContext = ctx
+	return n
+}
+
+// WithTimeout returns a copy of the client that limits each of its requests to
+// d unless their RequestOptions set a Timeout, see RequestOptions.Timeout.
+// Calls that make several requests, such as the paginated ListAll functions,
+// apply d to each request. Like WithToken, the copy shares the HTTPClient and
+// all other configuration with the original client.
+func (c *Client) WithTimeout(d time.Duration) *Client {
+	n := c.clone()
+	n.requestTimeout = d
+	return n
+}
+
+// clone returns a copy of the client's configuration.
+func (c *Client) clone() *Client {
+	c.rateLimitLock.RLock()
+	defer c.rateLimitLock.RUnlock()
+
+	return &Client{
+		Address:               c.Address,
+		HTTPClient:            c.HTTPClient,
+		Instrumentation:       c.Instrumentation,
+		apiKey:                c.getAPIKey(),
+		applications:          c.applications,
+		breaker:               c.breaker,
+		cache:                 c.cache,
+		compressMinSize:       c.compressMinSize,
+		limiter:               c.limiter,
+		logger:                c.logger,
+		realtimeStatsEndpoint: c.realtimeStatsEndpoint,
+		remaining:             c.remaining,
+		requestContext:        c.requestContext,
+		requestIDGenerator:    c.requestIDGenerator,
+		requestTimeout:        c.requestTimeout,
+		reset:                 c.reset,
+		responseMetadata:      c.responseMetadata,
+		retryBudget:           c.retryBudget,
+		retryPolicy:           c.retryPolicy,
+		statsEndpoint:         c.statsEndpoint,
+		statsURL:              c.statsURL,
+		tokenRefresher:        c.tokenRefresher,
+		transport:             c.transport,
+		transportOptions:      c.transportOptions,
+		url:                   c.url,
+		userAgent:             c.userAgent,
+		vclLint:               c.vclLint,
+		vclValidator:          c.vclValidator,
+	}
+}
+
 // RateLimitRemaining returns the number of non-read requests left before
 // rate limiting causes a 429 Too Many Requests error.
 func (c *Client) RateLimitRemaining() int {
+	c.rateLimitLock.RLock()
+	defer c.rateLimitLock.RUnlock()
 	return c.remaining
 }
 
 // RateLimitReset returns the next time the rate limiter's counter will be
 // reset.
 func (c *Client) RateLimitReset() time.Time {
+	c.rateLimitLock.RLock()
+	defer c.rateLimitLock.RUnlock()
 	return time.Unix(c.reset, 0)
 }
 
@@ -216,6 +464,11 @@ func (c *Client) PatchJSONAPI(p string, i interface{}, ro *RequestOptions) (*htt
 	return c.RequestJSONAPI("PATCH", p, i, ro)
 }
 
+// PatchJSONAPIBulk issues an HTTP PATCH request with the given interface json-encoded and bulk requests.
+func (c *Client) PatchJSONAPIBulk(p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
+	return c.RequestJSONAPIBulk("PATCH", p, i, ro)
+}
+
 // Post issues an HTTP POST request.
 func (c *Client) Post(p string, ro *RequestOptions) (*http.Response, error) {
 	return c.Request("POST", p, ro)
@@ -284,22 +537,115 @@ func (c *Client) DeleteJSONAPIBulk(p string, i interface{}, ro *RequestOptions)
 // Request makes an HTTP request against the HTTPClient using the given verb,
 // Path, and request options.
 func (c *Client) Request(verb, p string, ro *RequestOptions) (*http.Response, error) {
+	ro = c.withRequestDefaults(ro)
+
+	// The token is elevated like the request is sent: with the same token,
+	// and cancelled or timed out along with it.
+	if ro != nil && ro.Sudo != nil {
+		if _, err := c.sudo(ro.Sudo, &RequestOptions{
+			Token:   ro.Token,
+			Context: ro.Context,
+			Timeout: ro.Timeout,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := c.RawRequest(verb, p, ro)
 	if err != nil {
 		return nil, err
 	}
 
+	if ro == nil || ro.Timeout <= 0 {
+		return c.send(req, ro)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), ro.Timeout)
+	ctx = context.WithValue(ctx, requestTimeoutKey{}, ro.Timeout)
+	resp, err := c.send(req.WithContext(ctx), ro)
+
+	// The context must outlive this call so that the caller can still read
+	// the response body, so it is released when the body is closed.
+	if resp != nil && resp.Body != nil {
+		resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	} else {
+		cancel()
+	}
+	return resp, err
+}
+
+// withRequestDefaults returns ro with the client's default Context and
+// Timeout, if any, filled in. ro itself is not modified.
+func (c *Client) withRequestDefaults(ro *RequestOptions) *RequestOptions {
+	if c.requestContext == nil && c.requestTimeout <= 0 {
+		return ro
+	}
+
+	var o RequestOptions
+	if ro != nil {
+		o = *ro
+	}
+	if o.Context == nil {
+		o.Context = c.requestContext
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = c.requestTimeout
+	}
+	return &o
+}
+
+// requestTimeoutKey is the context key under which Request stores the Timeout
+// of the RequestOptions, see httpClient.
+type requestTimeoutKey struct{}
+
+// httpClient returns the HTTPClient to send req with. A request Timeout longer
+// than the Timeout of the HTTPClient takes precedence over it, in which case a
+// copy of the HTTPClient without Timeout is returned; the request is then
+// limited by the deadline of its context alone.
+func (c *Client) httpClient(req *http.Request) *http.Client {
+	d, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration)
+	if !ok || c.HTTPClient.Timeout <= 0 || d <= c.HTTPClient.Timeout {
+		return c.HTTPClient
+	}
+	hc := *c.HTTPClient
+	hc.Timeout = 0
+	return &hc
+}
+
+// Do issues a request for the given method and API path, such as
+// "/service/{id}/details", and returns the response as is. It is meant for
+// endpoints that have no typed support in this package yet: the request is
+// authenticated, identified with the client's User-Agent and retried, rate
+// limited and instrumented like any other request of the client.
+//
+// An unsuccessful response is returned as an *HTTPError. Otherwise the caller
+// must close the response body. ctx, if non-nil, replaces ro.Context.
+func (c *Client) Do(ctx context.Context, method, path string, ro RequestOptions) (*http.Response, error) {
+	if ctx != nil {
+		ro.Context = ctx
+	}
+	return c.Request(method, path, &ro)
+}
+
+// send issues the constructed request, serializing it with other modifying
+// requests unless it is allowed to run in parallel, and records the rate limit
+// information returned by the API.
+func (c *Client) send(req *http.Request, ro *RequestOptions) (*http.Response, error) {
 	if ro == nil || !ro.Parallel {
 		c.updateLock.Lock()
 		defer c.updateLock.Unlock()
 
 	}
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := c.do(req)
+	c.recordResponseMetadata(resp)
 	if err != nil {
 		return resp, err
 	}
 
-	if verb != "GET" && verb != "HEAD" {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		c.rateLimitLock.Lock()
+		defer c.rateLimitLock.Unlock()
+
 		remaining := resp.Header.Get("Fastly-RateLimit-Remaining")
 		if remaining != "" {
 			if val, err := strconv.Atoi(remaining); err == nil {
@@ -317,6 +663,77 @@ func (c *Client) Request(verb, p string, ro *RequestOptions) (*http.Response, er
 	return resp, nil
 }
 
+// do sends the request using the HTTPClient and verifies the response,
+// retrying it according to the client's RetryPolicy.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		if c.breaker != nil {
+			if err := c.breaker.allow(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.doOnceRefreshing(req)
+		if c.breaker != nil {
+			c.breaker.record(req, resp, err)
+		}
+
+		if c.retryPolicy == nil || retry >= c.retryPolicy.MaxRetries || !retryable(req, resp, err) {
+			return resp, err
+		}
+		d, ok := c.retryPolicy.retryDelay(retry, err)
+		if !ok {
+			c.logf("[fastly] not retrying %s %s: Retry-After of %s exceeds the maximum backoff", req.Method, req.URL.Path, d)
+			return resp, err
+		}
+		if c.retryBudget != nil && !c.retryBudget.take() {
+			c.logf("[fastly] not retrying %s %s: retry budget exhausted", req.Method, req.URL.Path)
+			return resp, err
+		}
+
+		c.logf("[fastly] retrying %s %s in %s (retry %d of %d): %v",
+			req.Method, req.URL.Path, d, retry+1, c.retryPolicy.MaxRetries, err)
+
+		if werr := waitRetry(req, resp, d); werr != nil {
+			return resp, err
+		}
+	}
+}
+
+// doOnce sends the request a single time, notifying the configured
+// Instrumentation (if any) along the way.
+func (c *Client) doOnce(req *http.Request) (*http.Response, error) {
+	if c.Instrumentation == nil {
+		return checkResp(c.httpClient(req).Do(req))
+	}
+
+	c.Instrumentation.RequestStarted(req.Method, req.URL.Path)
+	start := time.Now()
+
+	resp, err := checkResp(c.httpClient(req).Do(req))
+
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	c.Instrumentation.RequestCompleted(req.Method, req.URL.Path, status, time.Since(start), err)
+
+	return resp, err
+}
+
+// logf writes a message to the client's Logger, if one is configured.
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
 // RequestForm makes an HTTP request with the given interface being encoded as
 // form data.
 func (c *Client) RequestForm(verb, p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
@@ -479,6 +896,69 @@ func decodeBodyMap(body io.ReadCloser, out interface{}) error {
 	return decodeMap(parsed, out)
 }
 
+// decodeBodyStream decodes a JSON array from body one element at a time,
+// calling fn with each element, so that the array is never held in memory as
+// a whole. It stops at the first error returned by fn.
+func decodeBodyStream[T any](body io.ReadCloser, fn func(*T) error) error {
+	defer body.Close()
+
+	dec := json.NewDecoder(body)
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '[' {
+		return fmt.Errorf("expected a JSON array, got %v", tok)
+	}
+
+	for dec.More() {
+		var parsed interface{}
+		if err := dec.Decode(&parsed); err != nil {
+			return err
+		}
+		var item *T
+		if err := decodeMap(parsed, &item); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+// streamPages calls fn for each item of the paginated listing at path,
+// decoding the pages one at a time with decodeBodyStream. ro.Params must set
+// the first page to fetch; the following pages are taken from the "next"
+// relation of the Link header until there is none.
+func streamPages[T any](c *Client, path string, ro *RequestOptions, fn func(*T) error) error {
+	for {
+		resp, err := c.Get(path, ro)
+		if err != nil {
+			return err
+		}
+
+		var next string
+		for _, l := range link.ParseResponse(resp) {
+			if l.Rel == "next" {
+				if u, err := url.Parse(l.URI); err == nil {
+					next = u.Query().Get("page")
+				}
+			}
+		}
+
+		if err := decodeBodyStream(resp.Body, fn); err != nil {
+			return err
+		}
+		if next == "" || next == ro.Params["page"] {
+			return nil
+		}
+		ro.Params["page"] = next
+	}
+}
+
 // decodeMap decodes an `in` struct or map to a mapstructure tagged `out`.
 // It applies the decoder defaults used throughout go-fastly.
 // Note that this uses opposite argument order from Go's copy().
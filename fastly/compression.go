@@ -0,0 +1,80 @@
+package fastly
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sync"
+)
+
+// compressBody returns the gzip-compressed form of a request body of the given
+// length, if the client compresses request bodies (see
+// WithRequestCompression) and the body is large enough. When length is not
+// given, it is taken from the body's Len method, if any; bodies without one
+// are compressed as they are sent rather than buffered in full, and their
+// returned length is 0. Empty bodies are returned unchanged. ok reports
+// whether the body was compressed.
+func (c *Client) compressBody(body io.Reader, length int64) (_ io.Reader, _ int64, ok bool, err error) {
+	if c.compressMinSize <= 0 || body == nil {
+		return body, length, false, nil
+	}
+
+	if length <= 0 {
+		l, isLen := body.(interface{ Len() int })
+		if !isLen {
+			return &gzipStream{src: body}, 0, true, nil
+		}
+		length = int64(l.Len())
+	}
+	if length == 0 || length < c.compressMinSize {
+		return body, length, false, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.Copy(zw, body); err != nil {
+		return nil, 0, false, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, 0, false, err
+	}
+	return &buf, int64(buf.Len()), true, nil
+}
+
+// gzipStream compresses src as it is read. The compression only starts with
+// the first Read, so that nothing is leaked for a request that is never sent.
+type gzipStream struct {
+	src  io.Reader
+	once sync.Once
+	pr   *io.PipeReader
+}
+
+// Read implements io.Reader.
+func (g *gzipStream) Read(p []byte) (int, error) {
+	g.once.Do(func() {
+		pr, pw := io.Pipe()
+		g.pr = pr
+		go func() {
+			zw := gzip.NewWriter(pw)
+			_, err := io.Copy(zw, g.src)
+			if err == nil {
+				err = zw.Close()
+			}
+			pw.CloseWithError(err)
+		}()
+	})
+	if g.pr == nil {
+		return 0, io.ErrClosedPipe
+	}
+	return g.pr.Read(p)
+}
+
+// Close implements io.Closer. It stops the compression of a stream that has
+// not been read in full.
+func (g *gzipStream) Close() error {
+	g.once.Do(func() {})
+	if g.pr != nil {
+		return g.pr.Close()
+	}
+	return nil
+}
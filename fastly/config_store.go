@@ -0,0 +1,80 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ConfigStoreMetadata is the metadata of a Config Store.
+type ConfigStoreMetadata struct {
+	// ItemCount is the number of items in the store.
+	ItemCount int `mapstructure:"item_count"`
+}
+
+// GetConfigStoreMetadataInput is the input to the GetConfigStoreMetadata function.
+type GetConfigStoreMetadataInput struct {
+	// StoreID is the ID of the Config Store (required).
+	StoreID string
+}
+
+// GetConfigStoreMetadata returns the metadata of a Config Store, which can be
+// used to monitor how close the store is to its item limit.
+func (c *Client) GetConfigStoreMetadata(i *GetConfigStoreMetadataInput) (*ConfigStoreMetadata, error) {
+	if i.StoreID == "" {
+		return nil, ErrMissingStoreID
+	}
+
+	path := fmt.Sprintf("/resources/stores/config/%s/info", url.PathEscape(i.StoreID))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var csm *ConfigStoreMetadata
+	if err := decodeBodyMap(resp.Body, &csm); err != nil {
+		return nil, err
+	}
+	return csm, nil
+}
+
+// BatchModifyConfigStoreItemsInput is the input to the
+// BatchModifyConfigStoreItems function.
+type BatchModifyConfigStoreItemsInput struct {
+	// StoreID is the ID of the Config Store (required).
+	StoreID string `json:"-"`
+
+	// Items are the operations to apply, at most BatchModifyMaximumOperations.
+	Items []*BatchConfigStoreItem `json:"items"`
+}
+
+// BatchConfigStoreItem is a single operation of BatchModifyConfigStoreItems.
+type BatchConfigStoreItem struct {
+	Operation BatchOperation `json:"op"`
+	ItemKey   string         `json:"item_key"`
+	ItemValue string         `json:"item_value,omitempty"`
+}
+
+// BatchModifyConfigStoreItems creates, updates, upserts and deletes Config
+// Store items in a single request.
+func (c *Client) BatchModifyConfigStoreItems(i *BatchModifyConfigStoreItemsInput) error {
+	if i.StoreID == "" {
+		return ErrMissingStoreID
+	}
+
+	if len(i.Items) > BatchModifyMaximumOperations {
+		return ErrMaxExceededItems
+	}
+
+	path := fmt.Sprintf("/resources/stores/config/%s/items", url.PathEscape(i.StoreID))
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return err
+	}
+
+	var batchModifyResult map[string]string
+	if err := decodeBodyMap(resp.Body, &batchModifyResult); err != nil {
+		return err
+	}
+
+	return nil
+}
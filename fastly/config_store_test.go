@@ -0,0 +1,83 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_ConfigStore(t *testing.T) {
+	t.Parallel()
+
+	var batch BatchModifyConfigStoreItemsInput
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/resources/stores/config/store-id/info":
+			fmt.Fprint(w, `{"item_count":42}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/resources/stores/config/store-id/items":
+			if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"status":"ok"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.BatchModifyConfigStoreItems(&BatchModifyConfigStoreItemsInput{
+		StoreID: "store-id",
+		Items: []*BatchConfigStoreItem{
+			{Operation: UpsertBatchOperation, ItemKey: "a", ItemValue: "1"},
+			{Operation: DeleteBatchOperation, ItemKey: "b"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(batch.Items) != 2 || batch.Items[0].ItemValue != "1" || batch.Items[1].Operation != DeleteBatchOperation {
+		t.Errorf("bad batch: %+v", batch.Items)
+	}
+
+	csm, err := c.GetConfigStoreMetadata(&GetConfigStoreMetadataInput{
+		StoreID: "store-id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if csm.ItemCount != 42 {
+		t.Errorf("bad item count: %d", csm.ItemCount)
+	}
+}
+
+func TestClient_ConfigStore_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetConfigStoreMetadata(&GetConfigStoreMetadataInput{
+		StoreID: "",
+	})
+	if err != ErrMissingStoreID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyConfigStoreItems(&BatchModifyConfigStoreItemsInput{
+		StoreID: "",
+	})
+	if err != ErrMissingStoreID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyConfigStoreItems(&BatchModifyConfigStoreItemsInput{
+		StoreID: "store-id",
+		Items:   make([]*BatchConfigStoreItem, BatchModifyMaximumOperations+1),
+	})
+	if err != ErrMaxExceededItems {
+		t.Errorf("bad error: %s", err)
+	}
+}
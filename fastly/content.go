@@ -26,12 +26,19 @@ type EdgeCheckResponse struct {
 
 // EdgeCheckInput is used as input to the EdgeCheck function.
 type EdgeCheckInput struct {
+	// URL is the URL to check, with or without the scheme (required).
 	URL string `url:"url,omitempty"`
 }
 
 // EdgeCheck queries the edge cache for all of Fastly's servers for the given
-// URL.
+// URL. Each result holds the status and the hash of the object cached by one
+// server, so comparing the hashes shows whether content has propagated after a
+// purge.
 func (c *Client) EdgeCheck(i *EdgeCheckInput) ([]*EdgeCheck, error) {
+	if i.URL == "" {
+		return nil, ErrMissingURL
+	}
+
 	resp, err := c.Get("/content/edge_check", &RequestOptions{
 		Params: map[string]string{
 			"url": i.URL,
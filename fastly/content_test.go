@@ -19,3 +19,10 @@ func TestClient_EdgeCheck(t *testing.T) {
 		t.Errorf("bad edge check: %d", len(edges))
 	}
 }
+
+func TestClient_EdgeCheck_validation(t *testing.T) {
+	_, err := testClient.EdgeCheck(&EdgeCheckInput{})
+	if err != ErrMissingURL {
+		t.Errorf("bad error: %s", err)
+	}
+}
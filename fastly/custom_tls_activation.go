@@ -71,20 +71,11 @@ func (c *Client) ListTLSActivations(i *ListTLSActivationsInput) ([]*TLSActivatio
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(TLSActivation)))
+	a, err := decodeMany[TLSActivation](r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	a := make([]*TLSActivation, len(data))
-	for i := range data {
-		typed, ok := data[i].(*TLSActivation)
-		if !ok {
-			return nil, fmt.Errorf("unexpected response type: %T", data[i])
-		}
-		a[i] = typed
-	}
-
 	return a, nil
 }
 
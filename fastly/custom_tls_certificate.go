@@ -78,20 +78,11 @@ func (c *Client) ListCustomTLSCertificates(i *ListCustomTLSCertificatesInput) ([
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(CustomTLSCertificate)))
+	cc, err := decodeMany[CustomTLSCertificate](r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	cc := make([]*CustomTLSCertificate, len(data))
-	for i := range data {
-		typed, ok := data[i].(*CustomTLSCertificate)
-		if !ok {
-			return nil, fmt.Errorf("unexpected response type: %T", data[i])
-		}
-		cc[i] = typed
-	}
-
 	return cc, nil
 }
 
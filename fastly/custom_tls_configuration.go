@@ -79,20 +79,11 @@ func (c *Client) ListCustomTLSConfigurations(i *ListCustomTLSConfigurationsInput
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(CustomTLSConfiguration)))
+	con, err := decodeMany[CustomTLSConfiguration](r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	con := make([]*CustomTLSConfiguration, len(data))
-	for i := range data {
-		typed, ok := data[i].(*CustomTLSConfiguration)
-		if !ok {
-			return nil, fmt.Errorf("unexpected response type: %T", data[i])
-		}
-		con[i] = typed
-	}
-
 	return con, nil
 }
 
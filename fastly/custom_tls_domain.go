@@ -1,11 +1,7 @@
 package fastly
 
 import (
-	"fmt"
-	"reflect"
 	"strconv"
-
-	"github.com/google/jsonapi"
 )
 
 // ListTLSDomainsInput is used as input to Client.ListTLSDomains.
@@ -74,19 +70,10 @@ func (c *Client) ListTLSDomains(i *ListTLSDomainsInput) ([]*TLSDomain, error) {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(TLSDomain)))
+	a, err := decodeMany[TLSDomain](r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	a := make([]*TLSDomain, len(data))
-	for i := range data {
-		typed, ok := data[i].(*TLSDomain)
-		if !ok {
-			return nil, fmt.Errorf("unexpected response type: %T", data[i])
-		}
-		a[i] = typed
-	}
-
 	return a, nil
 }
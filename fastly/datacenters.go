@@ -18,15 +18,23 @@ type Datacenter struct {
 }
 
 // AllDatacenters returns the lists of datacenters for Fastly's network.
+//
+// The response is cached when the client was created with WithCache.
 func (c *Client) AllDatacenters() (datacenters []Datacenter, err error) {
-	resp, err := c.Get("/datacenters", nil)
+	v, err := c.cache.get("/datacenters", func() (interface{}, error) {
+		resp, err := c.Get("/datacenters", nil)
+		if err != nil {
+			return nil, err
+		}
+		var m []Datacenter
+		if err := decodeBodyMap(resp.Body, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	var m []Datacenter
-	if err := decodeBodyMap(resp.Body, &m); err != nil {
-		return nil, err
-	}
 
-	return m, nil
+	return append([]Datacenter(nil), v.([]Datacenter)...), nil
 }
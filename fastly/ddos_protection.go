@@ -0,0 +1,202 @@
+package fastly
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DDoSProtectionProduct is the ID of the DDoS protection product.
+const DDoSProtectionProduct = "ddos_protection"
+
+// DDoSProtection describes whether DDoS protection is enabled on a service.
+type DDoSProtection = ProductEnablement
+
+// DDoSProtectionInput is used as input to the GetDDoSProtection,
+// EnableDDoSProtection and DisableDDoSProtection functions.
+type DDoSProtectionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// GetDDoSProtection returns the DDoS protection product of a service. A 404
+// HTTPError is returned when the product is not enabled.
+func (c *Client) GetDDoSProtection(i *DDoSProtectionInput) (*DDoSProtection, error) {
+	return c.productEnablementRequest("GET", DDoSProtectionProduct, i.ServiceID)
+}
+
+// EnableDDoSProtection enables DDoS protection on a service.
+func (c *Client) EnableDDoSProtection(i *DDoSProtectionInput) (*DDoSProtection, error) {
+	return c.productEnablementRequest("PUT", DDoSProtectionProduct, i.ServiceID)
+}
+
+// DisableDDoSProtection disables DDoS protection on a service.
+func (c *Client) DisableDDoSProtection(i *DDoSProtectionInput) error {
+	return c.disableProduct(DDoSProtectionProduct, i.ServiceID)
+}
+
+// DDoSProtectionEvent is an attack detected by DDoS protection.
+type DDoSProtectionEvent struct {
+	ID         string     `mapstructure:"id"`
+	CustomerID string     `mapstructure:"customer_id"`
+	ServiceID  string     `mapstructure:"service_id"`
+	Name       string     `mapstructure:"name"`
+	Action     string     `mapstructure:"action"`
+	StartedAt  *time.Time `mapstructure:"started_at"`
+	EndedAt    *time.Time `mapstructure:"ended_at"`
+	CreatedAt  *time.Time `mapstructure:"created_at"`
+	UpdatedAt  *time.Time `mapstructure:"updated_at"`
+}
+
+// DDoSProtectionMeta holds the cursor pagination details of a list of DDoS
+// protection events or rules.
+type DDoSProtectionMeta struct {
+	NextCursor string `mapstructure:"next_cursor"`
+	Limit      int    `mapstructure:"limit"`
+}
+
+// DDoSProtectionEventsResponse is a page of DDoS protection events.
+type DDoSProtectionEventsResponse struct {
+	Data []*DDoSProtectionEvent `mapstructure:"data"`
+	Meta DDoSProtectionMeta     `mapstructure:"meta"`
+}
+
+// ListDDoSProtectionEventsInput is used as input to the
+// ListDDoSProtectionEvents function.
+type ListDDoSProtectionEventsInput struct {
+	// Cursor is the value of NextCursor from a previous page (optional).
+	Cursor string
+	// Limit is the maximum number of events to return (optional).
+	Limit int
+	// ServiceID limits the returned events to a service (optional).
+	ServiceID string
+	// From and To limit the returned events to a time range (optional).
+	From *time.Time
+	To   *time.Time
+}
+
+// ListDDoSProtectionEvents returns a page of DDoS protection events, most
+// recent first.
+func (c *Client) ListDDoSProtectionEvents(i *ListDDoSProtectionEventsInput) (*DDoSProtectionEventsResponse, error) {
+	ro := &RequestOptions{
+		Params: map[string]string{},
+	}
+	if i.Cursor != "" {
+		ro.Params["cursor"] = i.Cursor
+	}
+	if i.Limit != 0 {
+		ro.Params["limit"] = strconv.Itoa(i.Limit)
+	}
+	if i.ServiceID != "" {
+		ro.Params["service_id"] = i.ServiceID
+	}
+	if i.From != nil {
+		ro.Params["from"] = i.From.UTC().Format(time.RFC3339)
+	}
+	if i.To != nil {
+		ro.Params["to"] = i.To.UTC().Format(time.RFC3339)
+	}
+
+	resp, err := c.Get("/ddos-protection/v1/events", ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var er *DDoSProtectionEventsResponse
+	if err := decodeBodyMap(resp.Body, &er); err != nil {
+		return nil, err
+	}
+	return er, nil
+}
+
+// GetDDoSProtectionEventInput is used as input to the
+// GetDDoSProtectionEvent function.
+type GetDDoSProtectionEventInput struct {
+	// EventID is the ID of the event (required).
+	EventID string
+}
+
+// GetDDoSProtectionEvent returns a single DDoS protection event.
+func (c *Client) GetDDoSProtectionEvent(i *GetDDoSProtectionEventInput) (*DDoSProtectionEvent, error) {
+	if i.EventID == "" {
+		return nil, ErrMissingEventID
+	}
+
+	path := fmt.Sprintf("/ddos-protection/v1/events/%s", i.EventID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var e *DDoSProtectionEvent
+	if err := decodeBodyMap(resp.Body, &e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// DDoSProtectionRule is a traffic attribute rule created to mitigate a DDoS
+// protection event.
+type DDoSProtectionRule struct {
+	ID          string     `mapstructure:"id"`
+	EventID     string     `mapstructure:"event_id"`
+	CustomerID  string     `mapstructure:"customer_id"`
+	ServiceID   string     `mapstructure:"service_id"`
+	Action      string     `mapstructure:"action"`
+	SourceIP    string     `mapstructure:"source_ip"`
+	CountryCode string     `mapstructure:"country_code"`
+	Host        string     `mapstructure:"host"`
+	ASN         string     `mapstructure:"asn"`
+	JA4         string     `mapstructure:"ja4"`
+	Method      string     `mapstructure:"method"`
+	Path        string     `mapstructure:"path"`
+	CreatedAt   *time.Time `mapstructure:"created_at"`
+	UpdatedAt   *time.Time `mapstructure:"updated_at"`
+}
+
+// DDoSProtectionRulesResponse is a page of DDoS protection rules.
+type DDoSProtectionRulesResponse struct {
+	Data []*DDoSProtectionRule `mapstructure:"data"`
+	Meta DDoSProtectionMeta    `mapstructure:"meta"`
+}
+
+// ListDDoSProtectionEventRulesInput is used as input to the
+// ListDDoSProtectionEventRules function.
+type ListDDoSProtectionEventRulesInput struct {
+	// EventID is the ID of the event (required).
+	EventID string
+	// Cursor is the value of NextCursor from a previous page (optional).
+	Cursor string
+	// Limit is the maximum number of rules to return (optional).
+	Limit int
+}
+
+// ListDDoSProtectionEventRules returns a page of the rules created for a
+// DDoS protection event.
+func (c *Client) ListDDoSProtectionEventRules(i *ListDDoSProtectionEventRulesInput) (*DDoSProtectionRulesResponse, error) {
+	if i.EventID == "" {
+		return nil, ErrMissingEventID
+	}
+
+	ro := &RequestOptions{
+		Params: map[string]string{},
+	}
+	if i.Cursor != "" {
+		ro.Params["cursor"] = i.Cursor
+	}
+	if i.Limit != 0 {
+		ro.Params["limit"] = strconv.Itoa(i.Limit)
+	}
+
+	path := fmt.Sprintf("/ddos-protection/v1/events/%s/rules", i.EventID)
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var rr *DDoSProtectionRulesResponse
+	if err := decodeBodyMap(resp.Body, &rr); err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
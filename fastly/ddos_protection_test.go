@@ -0,0 +1,127 @@
+package fastly
+
+import "testing"
+
+func TestClient_DDoSProtection(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "ddos_protection/"
+
+	// Enable
+	var err error
+	var dp *DDoSProtection
+	record(t, fixtureBase+"enable", func(c *Client) {
+		dp, err = c.EnableDDoSProtection(&DDoSProtectionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure disabled
+	defer func() {
+		record(t, fixtureBase+"cleanup", func(c *Client) {
+			c.DisableDDoSProtection(&DDoSProtectionInput{
+				ServiceID: testServiceID,
+			})
+		})
+	}()
+
+	if dp.Product.ID != "ddos_protection" || dp.Service.ID != testServiceID {
+		t.Errorf("bad DDoS protection: %+v", dp)
+	}
+
+	// Get
+	record(t, fixtureBase+"get", func(c *Client) {
+		dp, err = c.GetDDoSProtection(&DDoSProtectionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dp.Service.ID != testServiceID {
+		t.Errorf("bad service: %q", dp.Service.ID)
+	}
+
+	// List events
+	var er *DDoSProtectionEventsResponse
+	record(t, fixtureBase+"list_events", func(c *Client) {
+		er, err = c.ListDDoSProtectionEvents(&ListDDoSProtectionEventsInput{
+			ServiceID: testServiceID,
+			Limit:     10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(er.Data) != 1 || er.Data[0].ServiceID != testServiceID {
+		t.Fatalf("bad events: %+v", er.Data)
+	}
+	if er.Data[0].StartedAt == nil || er.Data[0].EndedAt == nil {
+		t.Errorf("bad event times: %+v", er.Data[0])
+	}
+
+	// Get event
+	var e *DDoSProtectionEvent
+	record(t, fixtureBase+"get_event", func(c *Client) {
+		e, err = c.GetDDoSProtectionEvent(&GetDDoSProtectionEventInput{
+			EventID: er.Data[0].ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Name != "Attack on example.com" || e.Action != "block" {
+		t.Errorf("bad event: %+v", e)
+	}
+
+	// List event rules
+	var rr *DDoSProtectionRulesResponse
+	record(t, fixtureBase+"list_rules", func(c *Client) {
+		rr, err = c.ListDDoSProtectionEventRules(&ListDDoSProtectionEventRulesInput{
+			EventID: e.ID,
+			Limit:   10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.Data) != 1 || rr.Data[0].EventID != e.ID || rr.Data[0].Host != "example.com" {
+		t.Errorf("bad rules: %+v", rr.Data)
+	}
+
+	// Disable
+	record(t, fixtureBase+"disable", func(c *Client) {
+		err = c.DisableDDoSProtection(&DDoSProtectionInput{
+			ServiceID: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_DDoSProtection_validation(t *testing.T) {
+	var err error
+	_, err = testClient.EnableDDoSProtection(&DDoSProtectionInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DisableDDoSProtection(&DDoSProtectionInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetDDoSProtectionEvent(&GetDDoSProtectionEventInput{})
+	if err != ErrMissingEventID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListDDoSProtectionEventRules(&ListDDoSProtectionEventRulesInput{})
+	if err != ErrMissingEventID {
+		t.Errorf("bad error: %s", err)
+	}
+}
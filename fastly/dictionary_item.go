@@ -39,13 +39,26 @@ type ListDictionaryItemsInput struct {
 
 	// DictionaryID is the ID of the dictionary to retrieve items for (required).
 	DictionaryID string
-	Direction    string
-	PerPage      int
-	Page         int
-	Sort         string
+
+	// Direction is the direction in which to sort results, "ascend" or
+	// "descend".
+	Direction string
+
+	// PerPage is the number of items to return per page.
+	PerPage int
+
+	// Page is the current page.
+	Page int
+
+	// Sort is the field on which to sort, e.g. "item_key" or "created". When
+	// empty, the items are sorted by key.
+	Sort string
 }
 
-// ListDictionaryItems returns a list of items for a dictionary
+// ListDictionaryItems returns a list of items for a dictionary. Only the
+// requested page is returned when Page or PerPage is set, use
+// ListAllDictionaryItems or NewListDictionaryItemsPaginator to fetch every
+// item of a large dictionary.
 func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*DictionaryItem, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -55,8 +68,22 @@ func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*Dictionary
 		return nil, ErrMissingDictionaryID
 	}
 
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Direction != "" {
+		ro.Params["direction"] = i.Direction
+	}
+	if i.Page > 0 {
+		ro.Params["page"] = strconv.Itoa(i.Page)
+	}
+	if i.PerPage > 0 {
+		ro.Params["per_page"] = strconv.Itoa(i.PerPage)
+	}
+	if i.Sort != "" {
+		ro.Params["sort"] = i.Sort
+	}
+
 	path := fmt.Sprintf("/service/%s/dictionary/%s/items", i.ServiceID, i.DictionaryID)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -65,10 +92,78 @@ func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*Dictionary
 	if err := decodeBodyMap(resp.Body, &bs); err != nil {
 		return nil, err
 	}
-	sort.Stable(dictionaryItemsByKey(bs))
+	if i.Sort == "" {
+		sort.Stable(dictionaryItemsByKey(bs))
+	}
+	return bs, nil
+}
+
+// ListAllDictionaryItems returns every item of a dictionary, following the
+// pages of the listing until the last one. The items are returned in the
+// order given by Sort and Direction, page by page; Page is the first page to
+// fetch.
+func (c *Client) ListAllDictionaryItems(i *ListDictionaryItemsInput) ([]*DictionaryItem, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.DictionaryID == "" {
+		return nil, ErrMissingDictionaryID
+	}
+
+	var bs []*DictionaryItem
+	p := c.NewListDictionaryItemsPaginator(i)
+	for p.HasNext() {
+		items, err := p.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		bs = append(bs, items...)
+	}
 	return bs, nil
 }
 
+// StreamDictionaryItems calls fn for each item of a dictionary, in the order
+// returned by the API. Every page of the listing is fetched, starting at Page
+// (the first one by default) with PerPage items each (100 by default), and the
+// items are decoded one at a time, so memory use stays bounded for very large
+// dictionaries. Iteration stops at the first error returned by fn, which is
+// then returned.
+func (c *Client) StreamDictionaryItems(i *ListDictionaryItemsInput, fn func(*DictionaryItem) error) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+
+	if i.DictionaryID == "" {
+		return ErrMissingDictionaryID
+	}
+
+	page := i.Page
+	if page <= 0 {
+		page = 1
+	}
+	perPage := i.PerPage
+	if perPage <= 0 {
+		perPage = 100
+	}
+
+	ro := &RequestOptions{
+		Params: map[string]string{
+			"page":     strconv.Itoa(page),
+			"per_page": strconv.Itoa(perPage),
+		},
+	}
+	if i.Direction != "" {
+		ro.Params["direction"] = i.Direction
+	}
+	if i.Sort != "" {
+		ro.Params["sort"] = i.Sort
+	}
+
+	path := fmt.Sprintf("/service/%s/dictionary/%s/items", i.ServiceID, i.DictionaryID)
+	return streamPages(c, path, ro, fn)
+}
+
 type ListDictionaryItemsPaginator struct {
 	consumed    bool
 	CurrentPage int
@@ -175,7 +270,9 @@ func (c *Client) listDictionaryItemsWithPage(i *ListDictionaryItemsInput, p *Lis
 	if err := decodeBodyMap(resp.Body, &bs); err != nil {
 		return nil, err
 	}
-	sort.Stable(dictionaryItemsByKey(bs))
+	if i.Sort == "" {
+		sort.Stable(dictionaryItemsByKey(bs))
+	}
 
 	return bs, nil
 }
@@ -283,7 +380,9 @@ type UpdateDictionaryItemInput struct {
 	ItemValue string `url:"item_value"`
 }
 
-// UpdateDictionaryItem updates a specific dictionary item.
+// UpdateDictionaryItem sets the value of a specific dictionary item. The item
+// is created if the key does not exist yet, so a key can be written without
+// first checking whether it is present.
 func (c *Client) UpdateDictionaryItem(i *UpdateDictionaryItemInput) (*DictionaryItem, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -391,3 +490,98 @@ func (c *Client) DeleteDictionaryItem(i *DeleteDictionaryItemInput) error {
 	// response - it just returns a 200 OK.
 	return nil
 }
+
+// SyncDictionaryItemsInput is the input parameter to SyncDictionaryItems.
+type SyncDictionaryItemsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// DictionaryID is the ID of the dictionary to sync (required).
+	DictionaryID string
+
+	// Items is the full desired contents of the dictionary, keyed by item key.
+	Items map[string]string
+}
+
+// SyncDictionaryItemsResult summarises the changes made by SyncDictionaryItems.
+type SyncDictionaryItemsResult struct {
+	Upserted int
+	Deleted  int
+}
+
+// SyncDictionaryItems makes the items of a dictionary match the desired
+// contents. Existing items are fetched and only keys that are new or whose
+// value changed are upserted, and keys not in the desired contents are
+// deleted. Changes are applied through BatchModifyDictionaryItems in chunks
+// of BatchModifyMaximumOperations.
+func (c *Client) SyncDictionaryItems(i *SyncDictionaryItemsInput) (*SyncDictionaryItemsResult, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.DictionaryID == "" {
+		return nil, ErrMissingDictionaryID
+	}
+
+	existing := make(map[string]string)
+	p := c.NewListDictionaryItemsPaginator(&ListDictionaryItemsInput{
+		ServiceID:    i.ServiceID,
+		DictionaryID: i.DictionaryID,
+	})
+	for p.HasNext() {
+		items, err := p.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			existing[item.ItemKey] = item.ItemValue
+		}
+	}
+
+	var ops []*BatchDictionaryItem
+	var result SyncDictionaryItemsResult
+
+	keys := make([]string, 0, len(i.Items))
+	for k := range i.Items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v, ok := existing[k]; ok && v == i.Items[k] {
+			continue
+		}
+		ops = append(ops, &BatchDictionaryItem{
+			Operation: UpsertBatchOperation,
+			ItemKey:   k,
+			ItemValue: i.Items[k],
+		})
+		result.Upserted++
+	}
+
+	keys = keys[:0]
+	for k := range existing {
+		if _, ok := i.Items[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		ops = append(ops, &BatchDictionaryItem{
+			Operation: DeleteBatchOperation,
+			ItemKey:   k,
+		})
+		result.Deleted++
+	}
+
+	for _, items := range chunk(ops, BatchModifyMaximumOperations) {
+		if err := c.BatchModifyDictionaryItems(&BatchModifyDictionaryItemsInput{
+			ServiceID:    i.ServiceID,
+			DictionaryID: i.DictionaryID,
+			Items:        items,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
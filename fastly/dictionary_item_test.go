@@ -1,6 +1,11 @@
 package fastly
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -65,6 +70,28 @@ func TestClient_DictionaryItems(t *testing.T) {
 		t.Errorf("bad dictionary items: %v", dictionaryItems)
 	}
 
+	// Stream
+	var streamed int
+	errStop := errors.New("stop")
+	record(t, fixtureBase+"list2", func(c *Client) {
+		err = c.StreamDictionaryItems(&ListDictionaryItemsInput{
+			ServiceID:    testService.ID,
+			DictionaryID: testDictionary.ID,
+		}, func(item *DictionaryItem) error {
+			if item.ItemKey == "" {
+				t.Errorf("bad streamed item: %v", item)
+			}
+			streamed++
+			return errStop
+		})
+	})
+	if err != errStop {
+		t.Fatalf("expected the callback error, got %v", err)
+	}
+	if streamed != 1 {
+		t.Errorf("expected streaming to stop after the first item, got %d", streamed)
+	}
+
 	// List with paginator
 	var dictionaryItems2 []*DictionaryItem
 	var paginator PaginatorDictionaryItems
@@ -123,6 +150,26 @@ func TestClient_DictionaryItems(t *testing.T) {
 		t.Errorf("bad item_value: %q", updatedDictionaryItem.ItemValue)
 	}
 
+	// Update of a missing key
+	var upsertedDictionaryItem *DictionaryItem
+	record(t, fixtureBase+"upsert", func(c *Client) {
+		upsertedDictionaryItem, err = c.UpdateDictionaryItem(&UpdateDictionaryItemInput{
+			ServiceID:    testService.ID,
+			DictionaryID: testDictionary.ID,
+			ItemKey:      "test-dictionary-item-upsert",
+			ItemValue:    "upserted",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upsertedDictionaryItem.ItemKey != "test-dictionary-item-upsert" {
+		t.Errorf("bad item_key: %q", upsertedDictionaryItem.ItemKey)
+	}
+	if upsertedDictionaryItem.ItemValue != "upserted" {
+		t.Errorf("bad item_value: %q", upsertedDictionaryItem.ItemValue)
+	}
+
 	// Delete
 	record(t, fixtureBase+"delete", func(c *Client) {
 		err = c.DeleteDictionaryItem(&DeleteDictionaryItemInput{
@@ -279,3 +326,208 @@ func TestClient_BatchModifyDictionaryItem_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_SyncDictionaryItems(t *testing.T) {
+	t.Parallel()
+
+	var batches []BatchModifyDictionaryItemsInput
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items":
+			fmt.Fprint(w, `[{"item_key":"a","item_value":"1"},{"item_key":"b","item_value":"2"},{"item_key":"c","item_value":"3"}]`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items":
+			var b BatchModifyDictionaryItemsInput
+			if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			batches = append(batches, b)
+			fmt.Fprint(w, `{"status":"ok"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := map[string]string{"a": "1", "b": "changed"}
+	for n := 0; n < BatchModifyMaximumOperations; n++ {
+		desired[fmt.Sprintf("new-%04d", n)] = "v"
+	}
+
+	result, err := c.SyncDictionaryItems(&SyncDictionaryItemsInput{
+		ServiceID:    testServiceID,
+		DictionaryID: "dict-id",
+		Items:        desired,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *result != (SyncDictionaryItemsResult{Upserted: BatchModifyMaximumOperations + 1, Deleted: 1}) {
+		t.Errorf("bad result: %+v", *result)
+	}
+
+	if len(batches) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(batches))
+	}
+	if len(batches[0].Items) != BatchModifyMaximumOperations {
+		t.Errorf("expected a full first batch, got %d items", len(batches[0].Items))
+	}
+	if first := batches[0].Items[0]; first.Operation != UpsertBatchOperation || first.ItemKey != "b" || first.ItemValue != "changed" {
+		t.Errorf("bad first operation: %+v", first)
+	}
+	if last := batches[1].Items[len(batches[1].Items)-1]; last.Operation != DeleteBatchOperation || last.ItemKey != "c" {
+		t.Errorf("bad last operation: %+v", last)
+	}
+	for _, b := range batches {
+		for _, item := range b.Items {
+			if item.ItemKey == "a" {
+				t.Errorf("unchanged item was sent: %+v", item)
+			}
+		}
+	}
+}
+
+func TestClient_SyncDictionaryItems_validation(t *testing.T) {
+	var err error
+	_, err = testClient.SyncDictionaryItems(&SyncDictionaryItemsInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.SyncDictionaryItems(&SyncDictionaryItemsInput{
+		ServiceID:    "foo",
+		DictionaryID: "",
+	})
+	if err != ErrMissingDictionaryID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListAllDictionaryItems(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items" || q.Get("sort") != "created" || q.Get("direction") != "descend" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch q.Get("page") {
+		case "1":
+			if q.Get("per_page") != "2" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items?page=2&per_page=2>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items?page=2&per_page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"item_key":"c","item_value":"3"},{"item_key":"b","item_value":"2"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"item_key":"a","item_value":"1"}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := &ListDictionaryItemsInput{
+		ServiceID:    testServiceID,
+		DictionaryID: "dict-id",
+		PerPage:      2,
+		Sort:         "created",
+		Direction:    "descend",
+	}
+
+	items, err := c.ListAllDictionaryItems(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, item := range items {
+		keys = append(keys, item.ItemKey)
+	}
+	if fmt.Sprint(keys) != "[c b a]" {
+		t.Errorf("bad items: %v", keys)
+	}
+
+	input.Page = 2
+	items, err = c.ListDictionaryItems(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ItemKey != "a" {
+		t.Errorf("bad page 2: %v", items)
+	}
+}
+
+func TestClient_ListAllDictionaryItems_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListAllDictionaryItems(&ListDictionaryItemsInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListAllDictionaryItems(&ListDictionaryItemsInput{
+		ServiceID:    "foo",
+		DictionaryID: "",
+	})
+	if err != ErrMissingDictionaryID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_StreamDictionaryItems(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items" || q.Get("per_page") != "1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch q.Get("page") {
+		case "2":
+			w.Header().Set("Link", `<https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items?page=3&per_page=1>; rel="next", <https://api.fastly.com/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/dict-id/items?page=3&per_page=1>; rel="last"`)
+			fmt.Fprint(w, `[{"item_key":"b","item_value":"2"}]`)
+		case "3":
+			fmt.Fprint(w, `[{"item_key":"c","item_value":"3"}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	err = c.StreamDictionaryItems(&ListDictionaryItemsInput{
+		ServiceID:    testServiceID,
+		DictionaryID: "dict-id",
+		Page:         2,
+		PerPage:      1,
+	}, func(item *DictionaryItem) error {
+		keys = append(keys, item.ItemKey)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(keys) != "[b c]" {
+		t.Errorf("bad items: %v", keys)
+	}
+}
@@ -55,3 +55,95 @@ func (c *Client) GetDiff(i *GetDiffInput) (*Diff, error) {
 	}
 	return d, nil
 }
+
+// ResourceDiff lists the differences for one resource type between two
+// versions. Resources are identified by name.
+type ResourceDiff struct {
+	// Added lists the resources only present in the To version.
+	Added []string
+
+	// Removed lists the resources only present in the From version.
+	Removed []string
+
+	// Changed maps the resources present in both versions to the attributes
+	// that differ.
+	Changed map[string][]string
+}
+
+// VersionComparison is the structured difference between two versions.
+type VersionComparison struct {
+	From int
+	To   int
+
+	// Resources maps the API path of each resource type that differs (e.g.
+	// "backend", "logging/s3" or "settings") to its differences.
+	Resources map[string]*ResourceDiff
+}
+
+// CompareVersionsInput is used as input to the CompareVersions function.
+type CompareVersionsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// From is the version to compare from (required).
+	From int
+
+	// To is the version to compare to (required).
+	To int
+}
+
+// CompareVersions fetches the resources of both versions and returns which
+// resources were added, removed or changed. Unlike GetDiff, which returns a
+// textual diff of the generated VCL, the result is grouped by resource type.
+func (c *Client) CompareVersions(i *CompareVersionsInput) (*VersionComparison, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.From == 0 {
+		return nil, ErrMissingFrom
+	}
+
+	if i.To == 0 {
+		return nil, ErrMissingTo
+	}
+
+	from, err := c.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.From,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := c.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vc := &VersionComparison{
+		From:      i.From,
+		To:        i.To,
+		Resources: make(map[string]*ResourceDiff),
+	}
+	for _, ch := range DiffServiceConfig(from, to) {
+		rd, ok := vc.Resources[ch.Resource]
+		if !ok {
+			rd = &ResourceDiff{Changed: make(map[string][]string)}
+			vc.Resources[ch.Resource] = rd
+		}
+
+		switch ch.Action {
+		case ServiceConfigCreate:
+			rd.Added = append(rd.Added, ch.Name)
+		case ServiceConfigDelete:
+			rd.Removed = append(rd.Removed, ch.Name)
+		case ServiceConfigUpdate:
+			rd.Changed[ch.Name] = ch.Fields
+		}
+	}
+	return vc, nil
+}
@@ -1,6 +1,10 @@
 package fastly
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -97,3 +101,79 @@ func TestClient_Diff_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CompareVersions(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version/1/settings", "/service/foo/version/2/settings":
+			fmt.Fprint(w, `{}`)
+		case "/service/foo/version/1/backend":
+			fmt.Fprint(w, `[{"name":"origin","address":"example.com"},{"name":"old"}]`)
+		case "/service/foo/version/2/backend":
+			fmt.Fprint(w, `[{"name":"origin","address":"origin.example.com"},{"name":"new"}]`)
+		case "/service/foo/version/2/logging/s3":
+			fmt.Fprint(w, `[{"name":"logs"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vc, err := c.CompareVersions(&CompareVersionsInput{
+		ServiceID: "foo",
+		From:      1,
+		To:        2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]*ResourceDiff{
+		"backend": {
+			Added:   []string{"new"},
+			Removed: []string{"old"},
+			Changed: map[string][]string{"origin": {"address"}},
+		},
+		"logging/s3": {
+			Added:   []string{"logs"},
+			Changed: map[string][]string{},
+		},
+	}
+	if !reflect.DeepEqual(vc.Resources, want) {
+		t.Errorf("bad comparison: %#v", vc.Resources)
+	}
+}
+
+func TestClient_CompareVersions_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CompareVersions(&CompareVersionsInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CompareVersions(&CompareVersionsInput{
+		ServiceID: "foo",
+		From:      0,
+	})
+	if err != ErrMissingFrom {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CompareVersions(&CompareVersionsInput{
+		ServiceID: "foo",
+		From:      1,
+		To:        0,
+	})
+	if err != ErrMissingTo {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,32 @@
+package fastly
+
+import (
+	"time"
+)
+
+// DomainOwnership is a domain whose ownership has been verified for the
+// customer account.
+type DomainOwnership struct {
+	ID        string     `jsonapi:"primary,domain-ownership"`
+	Name      string     `jsonapi:"attr,name"`
+	CreatedAt *time.Time `jsonapi:"attr,created_at,iso8601"`
+	UpdatedAt *time.Time `jsonapi:"attr,updated_at,iso8601"`
+}
+
+// ListDomainOwnerships returns the domains owned by the customer account of
+// the authenticated user, so that it can be verified that a hostname belongs
+// to the account before adding it to a service.
+func (c *Client) ListDomainOwnerships() ([]*DomainOwnership, error) {
+	resp, err := c.Get("/domain-ownerships", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	dos, err := decodeMany[DomainOwnership](resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return dos, nil
+}
@@ -0,0 +1,25 @@
+package fastly
+
+import "testing"
+
+func TestClient_ListDomainOwnerships(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var dos []*DomainOwnership
+	record(t, "domain_ownerships/list", func(c *Client) {
+		dos, err = c.ListDomainOwnerships()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dos) != 2 {
+		t.Fatalf("expected 2 domain ownerships, got %d", len(dos))
+	}
+	if dos[0].Name != "example.com" || dos[1].Name != "www.example.com" {
+		t.Errorf("bad domain ownerships: %s, %s", dos[0].Name, dos[1].Name)
+	}
+	if dos[0].CreatedAt == nil {
+		t.Errorf("missing created_at")
+	}
+}
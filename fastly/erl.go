@@ -34,9 +34,9 @@ type ERL struct {
 
 // ERLResponseType models the response from the Fastly API.
 type ERLResponseType struct {
-	ERLStatus      int    `url:"status,omitempty"`
-	ERLContentType string `url:"content_type,omitempty"`
-	ERLContent     string `url:"content,omitempty"`
+	ERLStatus      int    `url:"status,omitempty" mapstructure:"status"`
+	ERLContentType string `url:"content_type,omitempty" mapstructure:"content_type"`
+	ERLContent     string `url:"content,omitempty" mapstructure:"content"`
 }
 
 // ERLAction represents the action variants for when a rate limiter
@@ -139,12 +139,15 @@ type CreateERLInput struct {
 	Action             ERLAction        `url:"action"`
 	ClientKey          []string         `url:"client_key,brackets"`
 	HttpMethods        []string         `url:"http_methods,brackets"`
+	LoggerType         ERLLogger        `url:"logger_type,omitempty"`
 	Name               string           `url:"name"`
 	PenaltyBoxDuration int              `url:"penalty_box_duration"`
 	Response           *ERLResponseType `url:"response,omitempty"`
+	ResponseObjectName string           `url:"response_object_name,omitempty"` // required if Action == ResponseObject
 	RpsLimit           int              `url:"rps_limit"`
 	ServiceID          string           `url:"-"`
 	ServiceVersion     int              `url:"-"`
+	UriDictionaryName  string           `url:"uri_dictionary_name,omitempty"`
 	WindowSize         ERLWindowSize    `url:"window_size"`
 }
 
@@ -156,6 +159,9 @@ func (c *Client) CreateERL(i *CreateERLInput) (*ERL, error) {
 	if i.ServiceVersion == 0 {
 		return nil, ErrMissingServiceVersion
 	}
+	if i.Action == ERLActionResponseObject && i.ResponseObjectName == "" {
+		return nil, ErrMissingResponseObjectName
+	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/rate-limiters", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
@@ -246,12 +252,15 @@ type UpdateERLInput struct {
 	ClientKey          []string         `url:"client_key,omitempty,brackets"`
 	HttpMethods        []string         `url:"http_methods,omitempty,brackets"`
 	ID                 string           `url:"id"`
+	LoggerType         ERLLogger        `url:"logger_type,omitempty"`
 	Name               string           `url:"name,omitempty"`
 	PenaltyBoxDuration int              `url:"penalty_box_duration,omitempty"`
 	Response           *ERLResponseType `url:"response,omitempty"`
+	ResponseObjectName string           `url:"response_object_name,omitempty"`
 	RpsLimit           int              `url:"rps_limit,omitempty"`
 	ServiceID          string           `url:"-"`
 	ServiceVersion     int              `url:"-"`
+	UriDictionaryName  string           `url:"uri_dictionary_name,omitempty"`
 	WindowSize         ERLWindowSize    `url:"window_size,omitempty"`
 }
 
@@ -280,3 +289,53 @@ func (c *Client) UpdateERL(i *UpdateERLInput) (*ERL, error) {
 
 	return erl, nil
 }
+
+// AttachERLToConditionInput is used as input to the AttachERLToCondition
+// function.
+type AttachERLToConditionInput struct {
+	ServiceID          string // required
+	ServiceVersion     int    // required
+	ERLID              string // required
+	ResponseObjectName string // required
+	RequestCondition   string // required
+}
+
+// AttachERLToCondition completes the setup of an ERL that responds with a
+// response object. The response object is given the request condition, so
+// that it is only served where the condition holds rather than to every
+// request, and the ERL is switched to the response_object action referencing
+// it. The response object and condition must already exist on the version.
+func (c *Client) AttachERLToCondition(i *AttachERLToConditionInput) (*ERL, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+	if i.ERLID == "" {
+		return nil, ErrMissingID
+	}
+	if i.ResponseObjectName == "" {
+		return nil, ErrMissingResponseObjectName
+	}
+	if i.RequestCondition == "" {
+		return nil, ErrMissingRequestCondition
+	}
+
+	if _, err := c.UpdateResponseObject(&UpdateResponseObjectInput{
+		ServiceID:        i.ServiceID,
+		ServiceVersion:   i.ServiceVersion,
+		Name:             i.ResponseObjectName,
+		RequestCondition: String(i.RequestCondition),
+	}); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateERL(&UpdateERLInput{
+		ServiceID:          i.ServiceID,
+		ServiceVersion:     i.ServiceVersion,
+		ID:                 i.ERLID,
+		Action:             ERLActionResponseObject,
+		ResponseObjectName: i.ResponseObjectName,
+	})
+}
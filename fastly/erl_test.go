@@ -1,7 +1,9 @@
 package fastly
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -155,6 +157,15 @@ func TestClient_CreateERL_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("error: %s", err)
 	}
+
+	_, err = testClient.CreateERL(&CreateERLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Action:         ERLActionResponseObject,
+	})
+	if err != ErrMissingResponseObjectName {
+		t.Errorf("error: %s", err)
+	}
 }
 
 func TestClient_GetERL_validation(t *testing.T) {
@@ -247,3 +258,98 @@ func TestClient_DeleteERL_validation(t *testing.T) {
 		t.Errorf("error: %s", err)
 	}
 }
+
+func TestClient_AttachERLToCondition(t *testing.T) {
+	t.Parallel()
+
+	var roCondition, erlAction, erlResponseObject string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/service/7i6HN3TK9wS159v2gPAZ8A/version/1/response_object/limited":
+			roCondition = r.PostForm.Get("request_condition")
+			fmt.Fprintf(w, `{"name":"limited","status":"429","request_condition":%q}`, roCondition)
+		case "/rate-limiters/erl-id":
+			erlAction = r.PostForm.Get("action")
+			erlResponseObject = r.PostForm.Get("response_object_name")
+			fmt.Fprintf(w, `{"id":"erl-id","action":%q,"response_object_name":%q}`, erlAction, erlResponseObject)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := c.AttachERLToCondition(&AttachERLToConditionInput{
+		ServiceID:          testServiceID,
+		ServiceVersion:     1,
+		ERLID:              "erl-id",
+		ResponseObjectName: "limited",
+		RequestCondition:   "is-rate-limited",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roCondition != "is-rate-limited" {
+		t.Errorf("bad response object condition: %q", roCondition)
+	}
+	if erlAction != string(ERLActionResponseObject) || erlResponseObject != "limited" {
+		t.Errorf("bad ERL update: action=%q response_object_name=%q", erlAction, erlResponseObject)
+	}
+	if e.Action != ERLActionResponseObject || e.ResponseObjectName != "limited" {
+		t.Errorf("bad ERL: %+v", e)
+	}
+}
+
+func TestClient_AttachERLToCondition_validation(t *testing.T) {
+	var err error
+	_, err = testClient.AttachERLToCondition(&AttachERLToConditionInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("error: %s", err)
+	}
+
+	_, err = testClient.AttachERLToCondition(&AttachERLToConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("error: %s", err)
+	}
+
+	_, err = testClient.AttachERLToCondition(&AttachERLToConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		ERLID:          "",
+	})
+	if err != ErrMissingID {
+		t.Errorf("error: %s", err)
+	}
+
+	_, err = testClient.AttachERLToCondition(&AttachERLToConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		ERLID:          "bar",
+	})
+	if err != ErrMissingResponseObjectName {
+		t.Errorf("error: %s", err)
+	}
+
+	_, err = testClient.AttachERLToCondition(&AttachERLToConditionInput{
+		ServiceID:          "foo",
+		ServiceVersion:     1,
+		ERLID:              "bar",
+		ResponseObjectName: "baz",
+	})
+	if err != ErrMissingRequestCondition {
+		t.Errorf("error: %s", err)
+	}
+}
@@ -5,14 +5,25 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/jsonapi"
 )
 
+// ErrMissingField is wrapped by every FieldError returned when an input
+// struct is missing a required field, so that it can be checked with
+// errors.Is regardless of which field is missing.
+var ErrMissingField = errors.New("missing required field")
+
+// ErrInvalidField is wrapped by every FieldError returned when an input
+// struct field is set to a value that is not accepted.
+var ErrInvalidField = errors.New("invalid field")
+
 // FieldError represents a custom error type for API data fields.
 type FieldError struct {
 	kind    string
 	message string
+	err     error
 }
 
 // Error fulfills the error interface.
@@ -33,15 +44,36 @@ func (e *FieldError) Error() string {
 	return fmt.Sprintf("missing required field '%s'", e.kind)
 }
 
+// Unwrap returns ErrMissingField or ErrInvalidField, depending on how the
+// error was created.
+func (e *FieldError) Unwrap() error {
+	return e.err
+}
+
+// Field returns the name of the input field the error is about.
+func (e *FieldError) Field() string {
+	return e.kind
+}
+
 func (e *FieldError) Message(msg string) *FieldError {
 	e.message = msg
 	return e
 }
 
-// NewFieldError returns an error that formats as the given text.
+// NewFieldError returns an error that formats as the given text. The error
+// wraps ErrMissingField.
 func NewFieldError(kind string) *FieldError {
 	return &FieldError{
 		kind: kind,
+		err:  ErrMissingField,
+	}
+}
+
+// newInvalidFieldError returns a FieldError that wraps ErrInvalidField.
+func newInvalidFieldError(kind string) *FieldError {
+	return &FieldError{
+		kind: kind,
+		err:  ErrInvalidField,
 	}
 }
 
@@ -49,15 +81,35 @@ const batchModifyMaxExceeded string = "batch modify maximum operations exceeded"
 
 // ErrMaxExceededEntries is an error that is returned when an input struct
 // specifies an "Entries" key value exceeding the maximum allowed.
-var ErrMaxExceededEntries = NewFieldError("Entries").Message(batchModifyMaxExceeded)
+var ErrMaxExceededEntries = newInvalidFieldError("Entries").Message(batchModifyMaxExceeded)
 
 // ErrMaxExceededItems is an error that is returned when an input struct
 // specifies an "Items" key value exceeding the maximum allowed.
-var ErrMaxExceededItems = NewFieldError("Items").Message(batchModifyMaxExceeded)
+var ErrMaxExceededItems = newInvalidFieldError("Items").Message(batchModifyMaxExceeded)
 
 // ErrMaxExceededRules is an error that is returned when an input struct
 // specifies an "Rules" key value exceeding the maximum allowed.
-var ErrMaxExceededRules = NewFieldError("Rules").Message(batchModifyMaxExceeded)
+var ErrMaxExceededRules = newInvalidFieldError("Rules").Message(batchModifyMaxExceeded)
+
+// ErrInvalidIP is an error that is returned when an input struct requires an
+// "IP" key holding an IPv4 or IPv6 address, but it could not be parsed.
+var ErrInvalidIP = newInvalidFieldError("IP").Message("must be an IPv4 or IPv6 address")
+
+// ErrInvalidNames is an error that is returned when an input struct
+// specifies a "Names" key that lists the same name more than once.
+var ErrInvalidNames = newInvalidFieldError("Names").Message("must not list a name more than once")
+
+// ErrInvalidPermission is an error that is returned when an input struct
+// specifies a "Permission" key that is not a known Permission.
+var ErrInvalidPermission = newInvalidFieldError("Permission").Message(`must be one of "full", "read_only", "purge_select" or "purge_all"`)
+
+// ErrInvalidSnippetType is an error that is returned when an input struct
+// specifies a "Type" key that is not a known SnippetType.
+var ErrInvalidSnippetType = newInvalidFieldError("Type").Message("unknown snippet type")
+
+// ErrInvalidWAFActiveRuleStatus is an error that is returned when an input
+// struct specifies a "Status" key that is not a known WAFActiveRuleStatus.
+var ErrInvalidWAFActiveRuleStatus = newInvalidFieldError("Status").Message(`must be one of "log", "block" or "score"`)
 
 // ErrMissingACLID is an error that is returned when an input struct
 // requires a "ACLID" key, but one was not set.
@@ -67,10 +119,22 @@ var ErrMissingACLID = NewFieldError("ACLID")
 // requires a "Address" key, but one was not set.
 var ErrMissingAddress = NewFieldError("Address")
 
+// ErrMissingApexRedirectID is an error that is returned when an input struct
+// requires a "ApexRedirectID" key, but one was not set.
+var ErrMissingApexRedirectID = NewFieldError("ApexRedirectID")
+
 // ErrMissingBackend is an error that is returned when an input struct
 // requires a "Backend" key, but one was not set.
 var ErrMissingBackend = NewFieldError("Backend")
 
+// ErrMissingBackendAddress is an error that is returned when an input struct
+// requires a "BackendAddress" key, but one was not set.
+var ErrMissingBackendAddress = NewFieldError("BackendAddress")
+
+// ErrMissingBody is an error that is returned when an input struct
+// requires a "Body" key, but one was not set.
+var ErrMissingBody = NewFieldError("Body")
+
 // ErrMissingCertBlob is an error that is returned when an input struct
 // requires a "CertBlob" key, but one was not set.
 var ErrMissingCertBlob = NewFieldError("CertBlob")
@@ -83,6 +147,14 @@ var ErrMissingContent = NewFieldError("Content")
 // requires a "Type" key, but one was not set.
 var ErrMissingType = NewFieldError("Type")
 
+// ErrMissingConfig is an error that is returned when an input struct
+// requires a "Config" key, but one was not set.
+var ErrMissingConfig = NewFieldError("Config")
+
+// ErrMissingConfigurationSetID is an error that is returned when an input
+// struct requires a "ConfigurationSetID" key, but one was not set.
+var ErrMissingConfigurationSetID = NewFieldError("ConfigurationSetID")
+
 // ErrMissingCustomerID is an error that is returned when an input struct
 // requires a "CustomerID" key, but one was not set.
 var ErrMissingCustomerID = NewFieldError("CustomerID")
@@ -95,6 +167,14 @@ var ErrMissingDictionaryID = NewFieldError("DictionaryID")
 // requires a "Director" key, but one was not set.
 var ErrMissingDirector = NewFieldError("Director")
 
+// ErrMissingDomain is an error that is returned when an input struct
+// requires a "Domain" key, but one was not set.
+var ErrMissingDomain = NewFieldError("Domain")
+
+// ErrMissingDomains is an error that is returned when an input struct
+// requires a "Domains" key, but one was not set.
+var ErrMissingDomains = NewFieldError("Domains").Message("expect at least one domain")
+
 // ErrMissingEventID is an error that is returned when an input struct
 // requires a "EventID" key, but one was not set.
 var ErrMissingEventID = NewFieldError("EventID")
@@ -105,7 +185,7 @@ var ErrMissingFrom = NewFieldError("From")
 
 // ErrMissingTokenID is an error that is returned when an input struct requires a
 // "TokenID" key, but one was not set.
-var ErrMissingTokenID = errors.New("missing required field 'TokenID'")
+var ErrMissingTokenID = NewFieldError("TokenID")
 
 // ErrMissingID is an error that is returned when an input struct
 // requires a "ID" key, but one was not set.
@@ -115,14 +195,26 @@ var ErrMissingID = NewFieldError("ID")
 // requires a "IP" key, but one was not set.
 var ErrMissingIP = NewFieldError("IP")
 
+// ErrMissingIntegrationID is an error that is returned when an input struct
+// requires a "IntegrationID" key, but one was not set.
+var ErrMissingIntegrationID = NewFieldError("IntegrationID")
+
 // ErrMissingIntermediatesBlob is an error that is returned when an input struct
 // requires a "IntermediatesBlob" key, but one was not set.
 var ErrMissingIntermediatesBlob = NewFieldError("IntermediatesBlob")
 
+// ErrMissingInvoiceID is an error that is returned when an input struct
+// requires a "InvoiceID" key, but one was not set.
+var ErrMissingInvoiceID = NewFieldError("InvoiceID")
+
 // ErrMissingItemKey is an error that is returned when an input struct
 // requires a "ItemKey" key, but one was not set.
 var ErrMissingItemKey = NewFieldError("ItemKey")
 
+// ErrMissingIDs is an error that is returned when an input struct requires
+// an "IDs" key, but one was not set.
+var ErrMissingIDs = NewFieldError("IDs").Message("expect at least one ID")
+
 // ErrMissingKey is an error that is returned when an input struct
 // requires a "Key" key, but one was not set.
 var ErrMissingKey = NewFieldError("Key")
@@ -139,6 +231,10 @@ var ErrMissingLogin = NewFieldError("Login")
 // requires a "Month" key, but one was not set.
 var ErrMissingMonth = NewFieldError("Month")
 
+// ErrMissingMutate is an error that is returned when an input struct
+// requires a "Mutate" key, but one was not set.
+var ErrMissingMutate = NewFieldError("Mutate")
+
 // ErrMissingName is an error that is returned when an input struct
 // requires a "Name" key, but one was not set.
 var ErrMissingName = NewFieldError("Name")
@@ -147,6 +243,10 @@ var ErrMissingName = NewFieldError("Name")
 // requires a "Name" key, but one was not set.
 var ErrMissingNameValue = NewFieldError("Name").Message("service name can't be an empty value")
 
+// ErrMissingNames is an error that is returned when an input struct requires a
+// "Names" key, but one was not set.
+var ErrMissingNames = NewFieldError("Names").Message("expect at least one name")
+
 // ErrMissingNewName is an error that is returned when an input struct
 // requires a "NewName" key, but one was not set.
 var ErrMissingNewName = NewFieldError("NewName")
@@ -159,6 +259,26 @@ var ErrMissingNumber = NewFieldError("Number")
 // requires a "PoolID" key, but one was not set.
 var ErrMissingPoolID = NewFieldError("PoolID")
 
+// ErrMissingRequestCondition is an error that is returned when an input
+// struct requires a "RequestCondition" key, but one was not set.
+var ErrMissingRequestCondition = NewFieldError("RequestCondition")
+
+// ErrMissingResponseObjectName is an error that is returned when an input
+// struct requires a "ResponseObjectName" key, but one was not set.
+var ErrMissingResponseObjectName = NewFieldError("ResponseObjectName")
+
+// ErrMissingSSLClientCert is an error that is returned when an input struct
+// sets an "SSLClientKey" without an "SSLClientCert".
+var ErrMissingSSLClientCert = NewFieldError("SSLClientCert").Message("SSLClientKey requires SSLClientCert")
+
+// ErrMissingSSLClientKey is an error that is returned when an input struct
+// sets an "SSLClientCert" without an "SSLClientKey".
+var ErrMissingSSLClientKey = NewFieldError("SSLClientKey").Message("SSLClientCert requires SSLClientKey")
+
+// ErrMissingSecret is an error that is returned when an input struct
+// requires a "Secret" key, but one was not set.
+var ErrMissingSecret = NewFieldError("Secret")
+
 // ErrMissingServer is an error that is returned when an input struct
 // requires a "Server" key, but one was not set.
 var ErrMissingServer = NewFieldError("Server")
@@ -171,6 +291,14 @@ var ErrMissingServerSideEncryptionKMSKeyID = NewFieldError("ServerSideEncryption
 // requires a "ServiceID" key, but one was not set.
 var ErrMissingServiceID = NewFieldError("ServiceID")
 
+// ErrMissingServiceIDs is an error that is returned when an input struct
+// requires a "ServiceIDs" key, but one was not set.
+var ErrMissingServiceIDs = NewFieldError("ServiceIDs").Message("expect at least one service ID")
+
+// ErrMissingServiceAuthorizations is an error that is returned when an input
+// struct requires a "ServiceAuthorizations" key, but one was not set.
+var ErrMissingServiceAuthorizations = NewFieldError("ServiceAuthorizations").Message("expect at least one service authorization")
+
 // ErrMissingServiceAuthorizationsService is an error that is returned when an input struct
 // requires a "Service" key of type SAService, but one was not set or was misconfigured.
 var ErrMissingServiceAuthorizationsService = NewFieldError("Service").Message("SAService requires an ID")
@@ -183,6 +311,14 @@ var ErrMissingServiceAuthorizationsUser = NewFieldError("User").Message("SAUser
 // requires a "UserID" key, but one was not set
 var ErrMissingUserID = NewFieldError("UserID")
 
+// ErrMissingPackagePath is an error that is returned when an input struct
+// requires a "PackagePath" key, but one was not set.
+var ErrMissingPackagePath = NewFieldError("PackagePath")
+
+// ErrMissingPassword is an error that is returned when an input struct
+// requires a "Password" key, but one was not set.
+var ErrMissingPassword = NewFieldError("Password")
+
 // ErrMissingPermissions is an error that is returned when an input struct
 // requires a "Permissions" key, but one was not set
 var ErrMissingPermissions = NewFieldError("Permissions")
@@ -191,6 +327,14 @@ var ErrMissingPermissions = NewFieldError("Permissions")
 // requires a "ServiceVersion" key, but one was not set.
 var ErrMissingServiceVersion = NewFieldError("ServiceVersion")
 
+// ErrMissingStatsField is an error that is returned when an input struct
+// requires a "Field" key, but one was not set.
+var ErrMissingStatsField = NewFieldError("Field")
+
+// ErrMissingStoreID is an error that is returned when an input struct
+// requires a "StoreID" key, but one was not set.
+var ErrMissingStoreID = NewFieldError("StoreID")
+
 // ErrMissingTLSCertificate is an error that is returned when an input struct
 // requires a "TLSCertificate" key, but one was not set.
 var ErrMissingTLSCertificate = NewFieldError("TLSCertificate")
@@ -205,7 +349,7 @@ var ErrMissingTLSDomain = NewFieldError("TLSDomain")
 
 // ErrCommonNameNotInDomains is an error that is returned when an input struct
 // requires that the domain in "CommonName" is also in "Domains"
-var ErrCommonNameNotInDomains = NewFieldError("CommonName").Message("CommonName must be in Domains")
+var ErrCommonNameNotInDomains = newInvalidFieldError("CommonName").Message("CommonName must be in Domains")
 
 // ErrMissingTo is an error that is returned when an input struct
 // requires a "To" key, but one was not set.
@@ -219,6 +363,10 @@ var ErrMissingKind = NewFieldError("Kind")
 // requires a "URL" key, but one was not set.
 var ErrMissingURL = NewFieldError("URL")
 
+// ErrMissingUsername is an error that is returned when an input struct
+// requires a "Username" key, but one was not set.
+var ErrMissingUsername = NewFieldError("Username")
+
 // ErrMissingWAFActiveRule is an error that is returned when an input struct
 // requires a "Rules" key, but there needs to be at least one WAFActiveRule entry.
 var ErrMissingWAFActiveRule = NewFieldError("Rules").Message("expect at least one WAFActiveRule")
@@ -227,6 +375,10 @@ var ErrMissingWAFActiveRule = NewFieldError("Rules").Message("expect at least on
 // requires a "WAFID" key, but one was not set.
 var ErrMissingWAFID = NewFieldError("WAFID")
 
+// ErrMissingWAFList is an error that is returned when an input struct
+// requires a "WAFList" key, but one was not set.
+var ErrMissingWAFList = NewFieldError("WAFList").Message("expect at least one WAF")
+
 // ErrMissingWAFRuleExclusion is an error that is returned when an input struct
 // requires a "WAFRuleExclusion" key, but one was not set.
 var ErrMissingWAFRuleExclusion = NewFieldError("WAFRuleExclusion")
@@ -235,6 +387,14 @@ var ErrMissingWAFRuleExclusion = NewFieldError("WAFRuleExclusion")
 // struct requires a "WAFRuleExclusionNumber" key, but one was not set.
 var ErrMissingWAFRuleExclusionNumber = NewFieldError("WAFRuleExclusionNumber")
 
+// ErrMissingWAFRuleID is an error that is returned when an input struct
+// requires a "WAFRuleID" key, but one was not set.
+var ErrMissingWAFRuleID = NewFieldError("WAFRuleID")
+
+// ErrMissingWAFRuleRevisionNumber is an error that is returned when an input
+// struct requires a "WAFRuleRevisionNumber" key, but one was not set.
+var ErrMissingWAFRuleRevisionNumber = NewFieldError("WAFRuleRevisionNumber")
+
 // ErrMissingWAFVersionID is an error that is returned when an input struct
 // requires a "WAFVersionID" key, but one was not set.
 var ErrMissingWAFVersionID = NewFieldError("WAFVersionID")
@@ -265,6 +425,14 @@ var ErrNotOK = errors.New("not ok")
 // ErrNotImplemented is a generic error indicating that something is not yet implemented.
 var ErrNotImplemented = errors.New("not implemented")
 
+// ErrInvalidClientKeySignature is an error that is returned when a Secret
+// Store client key is not signed by the API's signing key.
+var ErrInvalidClientKeySignature = errors.New("invalid client key signature")
+
+// ErrInvalidPackage is an error that is returned when a local package does
+// not pass the checks of InspectPackage.
+var ErrInvalidPackage = errors.New("invalid package")
+
 // ErrManagedLoggingEnabled is an error that indicates that managed logging was
 // already enabled for a service.
 var ErrManagedLoggingEnabled = errors.New("managed logging already enabled")
@@ -278,6 +446,15 @@ type HTTPError struct {
 	// StatusCode is the HTTP status code (2xx-5xx).
 	StatusCode int
 
+	// RequestID is the value of the RequestIDHeader returned by the API, which
+	// identifies the failed request in Fastly's logs.
+	RequestID string
+
+	// RetryAfter is the delay the API asked to wait before retrying, from the
+	// Retry-After header of a 429 Too Many Requests or 503 Service
+	// Unavailable response. It is zero if the header was not set.
+	RetryAfter time.Duration
+
 	Errors []*ErrorObject `mapstructure:"errors"`
 }
 
@@ -297,12 +474,20 @@ type ErrorObject struct {
 type legacyError struct {
 	Message string `mapstructure:"msg"`
 	Detail  string `mapstructure:"detail"`
+
+	// AltMessage holds the message of APIs that use a "message" key, such
+	// as the Next-Gen WAF API.
+	AltMessage string `mapstructure:"message"`
 }
 
 // NewHTTPError creates a new HTTP error from the given code.
 func NewHTTPError(resp *http.Response) *HTTPError {
 	var e HTTPError
 	e.StatusCode = resp.StatusCode
+	e.RequestID = resp.Header.Get(RequestIDHeader)
+	if e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusServiceUnavailable {
+		e.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
 
 	if resp.Body == nil {
 		return &e
@@ -317,6 +502,9 @@ func NewHTTPError(resp *http.Response) *HTTPError {
 		var lerr *legacyError
 		decodeBodyMap(resp.Body, &lerr)
 		if lerr != nil {
+			if lerr.Message == "" {
+				lerr.Message = lerr.AltMessage
+			}
 			e.Errors = append(e.Errors, &ErrorObject{
 				Title:  lerr.Message,
 				Detail: lerr.Detail,
@@ -334,6 +522,14 @@ func (e *HTTPError) Error() string {
 
 	fmt.Fprintf(&b, "%d - %s:", e.StatusCode, http.StatusText(e.StatusCode))
 
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, "\n    Request ID: %s", e.RequestID)
+	}
+
+	if e.RetryAfter > 0 {
+		fmt.Fprintf(&b, "\n    Retry after: %s", e.RetryAfter)
+	}
+
 	for _, e := range e.Errors {
 		fmt.Fprintf(&b, "\n")
 
@@ -371,3 +567,10 @@ func (e *HTTPError) String() string {
 func (e *HTTPError) IsNotFound() bool {
 	return e.StatusCode == 404
 }
+
+// IsNotModified returns true if the HTTP error code is a 304, false otherwise.
+// This is returned for conditional requests (see RequestOptions.IfNoneMatch)
+// when the resource has not changed.
+func (e *HTTPError) IsNotModified() bool {
+	return e.StatusCode == 304
+}
@@ -2,6 +2,8 @@ package fastly
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -74,4 +76,56 @@ func TestNewHTTPError(t *testing.T) {
 			t.Error("not not found")
 		}
 	})
+
+	t.Run("request_id", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: 500,
+			Header:     http.Header(map[string][]string{RequestIDHeader: {"req-123"}}),
+			Body: ioutil.NopCloser(bytes.NewBufferString(
+				`{"msg": "oops"}`)),
+		}
+		e := NewHTTPError(resp)
+
+		if e.RequestID != "req-123" {
+			t.Errorf("bad request ID: %q", e.RequestID)
+		}
+
+		expected := strings.TrimSpace(`
+500 - Internal Server Error:
+    Request ID: req-123
+
+    Title:  oops
+`)
+		if e.Error() != expected {
+			t.Errorf("expected \n\n%s\n\n to be \n\n%s\n\n", e.Error(), expected)
+		}
+	})
+}
+
+func TestFieldError(t *testing.T) {
+	t.Parallel()
+
+	_, err := testClient.GetVersion(&GetVersionInput{})
+	if !errors.Is(err, ErrMissingServiceID) {
+		t.Errorf("expected ErrMissingServiceID, got %v", err)
+	}
+	if !errors.Is(err, ErrMissingField) {
+		t.Errorf("expected error to wrap ErrMissingField, got %v", err)
+	}
+
+	wrapped := fmt.Errorf("creating version: %w", err)
+	var fe *FieldError
+	if !errors.As(wrapped, &fe) {
+		t.Fatalf("expected a *FieldError, got %T", err)
+	}
+	if fe.Field() != "ServiceID" {
+		t.Errorf("bad field: %q", fe.Field())
+	}
+
+	if !errors.Is(ErrMaxExceededRules, ErrInvalidField) || errors.Is(ErrMaxExceededRules, ErrMissingField) {
+		t.Errorf("expected ErrMaxExceededRules to only wrap ErrInvalidField")
+	}
+	if ErrMissingServiceID.Error() != "missing required field 'ServiceID'" {
+		t.Errorf("bad message: %q", ErrMissingServiceID.Error())
+	}
 }
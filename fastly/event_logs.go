@@ -132,18 +132,11 @@ func (c *Client) interpretAPIEventsPage(answer *GetAPIEventsResponse, pageNum in
 		return err
 	}
 	answer.Links = pages
-	data, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(Event)))
+	events, err := decodeMany[Event](body)
 	if err != nil {
 		return err
 	}
-
-	for i := range data {
-		typed, ok := data[i].(*Event)
-		if !ok {
-			return fmt.Errorf("got back response of unexpected type")
-		}
-		answer.Events = append(answer.Events, typed)
-	}
+	answer.Events = append(answer.Events, events...)
 	if pageNum == 0 {
 		if pages.Next != "" {
 			// NOTE: pages.Next URL includes filters already
@@ -158,21 +151,18 @@ func (c *Client) interpretAPIEventsPage(answer *GetAPIEventsResponse, pageNum in
 	return nil
 }
 
-// getEventsPages parses a response to get the pagination data without destroying
-// the reader we receive as "resp.Body"; this essentially copies resp.Body
-// and returns it so we can use it again.
+// getEventsPages reads a response to get the pagination data, returning it
+// together with a reader over the response so that the events can be decoded
+// without reading or buffering the response a second time.
 func getEventsPages(body io.Reader) (EventsPaginationInfo, io.Reader, error) {
-	var buf bytes.Buffer
-	tee := io.TeeReader(body, &buf)
-
-	bodyBytes, err := ioutil.ReadAll(tee)
+	bodyBytes, err := ioutil.ReadAll(body)
 	if err != nil {
 		return EventsPaginationInfo{}, nil, err
 	}
 
 	var pages *GetAPIEventsResponse
 	json.Unmarshal(bodyBytes, &pages)
-	return pages.Links, bytes.NewReader(buf.Bytes()), nil
+	return pages.Links, bytes.NewReader(bodyBytes), nil
 }
 
 // formatEventFilters converts user input into query parameters for filtering
@@ -25,6 +25,20 @@ const (
 	MaximumACLSize = 10000
 )
 
+// chunk splits s into consecutive slices of at most n elements, e.g. to send
+// a list of operations in batches of BatchModifyMaximumOperations.
+func chunk[T any](s []T, n int) [][]T {
+	var chunks [][]T
+	for len(s) > n {
+		chunks = append(chunks, s[:n])
+		s = s[n:]
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
+}
+
 type statusResp struct {
 	Status string
 	Msg    string
@@ -41,6 +55,8 @@ var (
 )
 
 // Helper function to get a pointer to bool
+//
+// Deprecated: input fields are now *bool; use Bool or ToPointer instead.
 func CBool(b bool) *Compatibool {
 	c := Compatibool(b)
 	return &c
@@ -48,6 +64,9 @@ func CBool(b bool) *Compatibool {
 
 // Compatibool is a boolean value that marshalls to 0/1 instead of true/false
 // for compatibility with Fastly's API.
+//
+// Deprecated: input fields are now *bool and are encoded as 0/1 using the
+// `url:",int"` tag option. Compatibool is no longer used by this package.
 type Compatibool bool
 
 // MarshalText implements the encoding.TextMarshaler interface.
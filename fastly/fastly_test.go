@@ -662,3 +662,20 @@ Wm7DCfrPNGVwFWUQOmsPue9rZBgO
 -----END CERTIFICATE-----
 `
 }
+
+func TestChunk(t *testing.T) {
+	for _, tc := range []struct {
+		s    []int
+		n    int
+		want string
+	}{
+		{s: nil, n: 2, want: "[]"},
+		{s: []int{1}, n: 2, want: "[[1]]"},
+		{s: []int{1, 2}, n: 2, want: "[[1 2]]"},
+		{s: []int{1, 2, 3, 4, 5}, n: 2, want: "[[1 2] [3 4] [5]]"},
+	} {
+		if got := fmt.Sprint(chunk(tc.s, tc.n)); got != tc.want {
+			t.Errorf("chunk(%v, %d): expected %s, got %s", tc.s, tc.n, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package fastly
+
+// Code generated into this package: the resources generated from the Fastly
+// OpenAPI specification by tools/fastly-gen, from the excerpts of the
+// specification kept in the openapi directory, and the methods of
+// ServiceVersionClient generated by tools/service-version-gen. Run "make
+// generate" after changing them, and "make check-generate" to verify the
+// generated files are up to date.
+
+//go:generate go run ../tools/fastly-gen -spec openapi/gzip.json -path /service/{service_id}/version/{version_id}/gzip -type Gzip -out gzip_gen.go
+//go:generate go run ../tools/service-version-gen -out service_version_client_gen.go
@@ -137,7 +137,7 @@ type CreateHeaderInput struct {
 
 	Name              string       `url:"name,omitempty"`
 	Action            HeaderAction `url:"action,omitempty"`
-	IgnoreIfSet       Compatibool  `url:"ignore_if_set,omitempty"`
+	IgnoreIfSet       *bool        `url:"ignore_if_set,omitempty,int"`
 	Type              HeaderType   `url:"type,omitempty"`
 	Destination       string       `url:"dst,omitempty"`
 	Source            string       `url:"src,omitempty"`
@@ -224,7 +224,7 @@ type UpdateHeaderInput struct {
 
 	NewName           *string       `url:"name,omitempty"`
 	Action            *HeaderAction `url:"action,omitempty"`
-	IgnoreIfSet       *Compatibool  `url:"ignore_if_set,omitempty"`
+	IgnoreIfSet       *bool         `url:"ignore_if_set,omitempty,int"`
 	Type              *HeaderType   `url:"type,omitempty"`
 	Destination       *string       `url:"dst,omitempty"`
 	Source            *string       `url:"src,omitempty"`
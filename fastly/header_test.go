@@ -21,7 +21,7 @@ func TestClient_Headers(t *testing.T) {
 			ServiceVersion: tv.Number,
 			Name:           "test-header",
 			Action:         HeaderActionSet,
-			IgnoreIfSet:    false,
+			IgnoreIfSet:    Bool(false),
 			Type:           HeaderTypeRequest,
 			Destination:    "http.foo",
 			Source:         "client.ip",
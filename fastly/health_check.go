@@ -24,6 +24,7 @@ type HealthCheck struct {
 	Window           uint       `mapstructure:"window"`
 	Threshold        uint       `mapstructure:"threshold"`
 	Initial          uint       `mapstructure:"initial"`
+	Headers          []string   `mapstructure:"headers"`
 	CreatedAt        *time.Time `mapstructure:"created_at"`
 	UpdatedAt        *time.Time `mapstructure:"updated_at"`
 	DeletedAt        *time.Time `mapstructure:"deleted_at"`
@@ -93,6 +94,10 @@ type CreateHealthCheckInput struct {
 	Window           *uint  `url:"window,omitempty"`
 	Threshold        *uint  `url:"threshold,omitempty"`
 	Initial          *uint  `url:"initial,omitempty"`
+
+	// Headers are sent with the health check request, each formatted as
+	// "Name: value", e.g. "Authorization: Bearer abc".
+	Headers []string `url:"headers,omitempty,brackets"`
 }
 
 // CreateHealthCheck creates a new Fastly health check.
@@ -180,6 +185,10 @@ type UpdateHealthCheckInput struct {
 	Window           *uint   `url:"window,omitempty"`
 	Threshold        *uint   `url:"threshold,omitempty"`
 	Initial          *uint   `url:"initial,omitempty"`
+
+	// Headers, if set, replaces the headers sent with the health check
+	// request, each formatted as "Name: value".
+	Headers *[]string `url:"headers,omitempty,brackets"`
 }
 
 // UpdateHealthCheck updates a specific health check.
@@ -30,6 +30,7 @@ func TestClient_HealthChecks(t *testing.T) {
 			Window:           Uint(5000),
 			Threshold:        Uint(10),
 			Initial:          Uint(10),
+			Headers:          []string{"Authorization: Bearer abc", "Host: origin.example.com"},
 		})
 	})
 	if err != nil {
@@ -86,6 +87,9 @@ func TestClient_HealthChecks(t *testing.T) {
 	if hc.Initial != 10 {
 		t.Errorf("bad initial: %q", hc.Initial)
 	}
+	if len(hc.Headers) != 2 || hc.Headers[0] != "Authorization: Bearer abc" || hc.Headers[1] != "Host: origin.example.com" {
+		t.Errorf("bad headers: %q", hc.Headers)
+	}
 
 	// List
 	var hcs []*HealthCheck
@@ -147,6 +151,9 @@ func TestClient_HealthChecks(t *testing.T) {
 	if hc.Initial != nhc.Initial {
 		t.Errorf("bad initial: %q", hc.Initial)
 	}
+	if len(nhc.Headers) != len(hc.Headers) {
+		t.Errorf("bad headers: %q", nhc.Headers)
+	}
 
 	// Update
 	var uhc *HealthCheck
@@ -156,6 +163,7 @@ func TestClient_HealthChecks(t *testing.T) {
 			ServiceVersion: tv.Number,
 			Name:           "test-healthcheck",
 			NewName:        String("new-test-healthcheck"),
+			Headers:        &[]string{"Host: origin.example.com"},
 		})
 	})
 	if err != nil {
@@ -164,6 +172,9 @@ func TestClient_HealthChecks(t *testing.T) {
 	if uhc.Name != "new-test-healthcheck" {
 		t.Errorf("bad name: %q", uhc.Name)
 	}
+	if len(uhc.Headers) != 1 || uhc.Headers[0] != "Host: origin.example.com" {
+		t.Errorf("bad headers: %q", uhc.Headers)
+	}
 
 	// Delete
 	record(t, "health_checks/delete", func(c *Client) {
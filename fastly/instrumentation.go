@@ -0,0 +1,22 @@
+package fastly
+
+import "time"
+
+// Instrumentation is implemented by types that want to observe the requests
+// issued by a Client, e.g. to export API call rates, error rates and latencies
+// as Prometheus metrics from a long-running controller.
+//
+// The endpoint passed to both methods is the URL path of the request. Paths
+// include resource identifiers (such as service IDs), so implementations that
+// export it as a metric label may want to normalise it first.
+//
+// Implementations must be safe for concurrent use.
+type Instrumentation interface {
+	// RequestStarted is called immediately before a request is sent.
+	RequestStarted(method, endpoint string)
+
+	// RequestCompleted is called once a response (or error) has been received.
+	// The status is zero when no response was received at all, and err is
+	// non-nil for both transport failures and non-2xx responses.
+	RequestCompleted(method, endpoint string, status int, duration time.Duration, err error)
+}
@@ -0,0 +1,61 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testInstrumentation struct {
+	mu        sync.Mutex
+	started   []string
+	completed []int
+}
+
+func (i *testInstrumentation) RequestStarted(method, endpoint string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.started = append(i.started, method+" "+endpoint)
+}
+
+func (i *testInstrumentation) RequestCompleted(method, endpoint string, status int, duration time.Duration, err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.completed = append(i.completed, status)
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	c, err := NewClientForEndpoint("", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst := &testInstrumentation{}
+	c.Instrumentation = inst
+
+	if _, err := c.Get("/service", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("/missing", nil); err == nil {
+		t.Fatal("expected error for 404 response")
+	}
+
+	if len(inst.started) != 2 || inst.started[0] != "GET /service" || inst.started[1] != "GET /missing" {
+		t.Errorf("bad started: %v", inst.started)
+	}
+	if len(inst.completed) != 2 || inst.completed[0] != 200 || inst.completed[1] != 404 {
+		t.Errorf("bad completed: %v", inst.completed)
+	}
+}
@@ -0,0 +1,146 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Invoice is an invoice of the account, as returned by the billing invoices
+// API.
+type Invoice struct {
+	CustomerID               string             `mapstructure:"customer_id"`
+	InvoiceID                string             `mapstructure:"invoice_id"`
+	InvoicePostedOn          *time.Time         `mapstructure:"invoice_posted_on"`
+	BillingStartDate         *time.Time         `mapstructure:"billing_start_date"`
+	BillingEndDate           *time.Time         `mapstructure:"billing_end_date"`
+	StatementNumber          string             `mapstructure:"statement_number"`
+	CurrencyCode             string             `mapstructure:"currency_code"`
+	MonthlyTransactionAmount float64            `mapstructure:"monthly_transaction_amount"`
+	LineItems                []*InvoiceLineItem `mapstructure:"transaction_line_items"`
+}
+
+// InvoiceLineItem is a single charge of an Invoice.
+type InvoiceLineItem struct {
+	Description      string  `mapstructure:"description"`
+	Amount           float64 `mapstructure:"amount"`
+	CreditCouponCode string  `mapstructure:"credit_coupon_code"`
+	Rate             float64 `mapstructure:"rate"`
+	Units            float64 `mapstructure:"units"`
+	ProductName      string  `mapstructure:"product_name"`
+	ProductGroup     string  `mapstructure:"product_group"`
+	ProductLine      string  `mapstructure:"product_line"`
+	Region           string  `mapstructure:"region"`
+	UsageType        string  `mapstructure:"usage_type"`
+}
+
+// InvoicesMeta holds the cursor pagination details of a list of invoices.
+type InvoicesMeta struct {
+	NextCursor string `mapstructure:"next_cursor"`
+	Limit      int    `mapstructure:"limit"`
+	Total      int    `mapstructure:"total"`
+}
+
+// InvoicesResponse is a page of invoices.
+type InvoicesResponse struct {
+	Data []*Invoice   `mapstructure:"data"`
+	Meta InvoicesMeta `mapstructure:"meta"`
+}
+
+// ListInvoicesInput is used as input to the ListInvoices function.
+type ListInvoicesInput struct {
+	// Cursor is the value of NextCursor from a previous page (optional).
+	Cursor string
+	// Limit is the maximum number of invoices to return (optional).
+	Limit int
+	// BillingStartDate and BillingEndDate limit the returned invoices to
+	// billing periods within a date range (optional).
+	BillingStartDate *time.Time
+	BillingEndDate   *time.Time
+}
+
+// ListInvoices returns a page of the account's invoices. Use
+// NewListInvoicesPaginator to iterate over all of the invoices.
+func (c *Client) ListInvoices(i *ListInvoicesInput) (*InvoicesResponse, error) {
+	ro := &RequestOptions{
+		Params: map[string]string{},
+	}
+	if i.Cursor != "" {
+		ro.Params["cursor"] = i.Cursor
+	}
+	if i.Limit != 0 {
+		ro.Params["limit"] = strconv.Itoa(i.Limit)
+	}
+	if i.BillingStartDate != nil {
+		ro.Params["billing_start_date"] = i.BillingStartDate.UTC().Format("2006-01-02")
+	}
+	if i.BillingEndDate != nil {
+		ro.Params["billing_end_date"] = i.BillingEndDate.UTC().Format("2006-01-02")
+	}
+
+	resp, err := c.Get("/billing/v3/invoices", ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var ir *InvoicesResponse
+	if err := decodeBodyMap(resp.Body, &ir); err != nil {
+		return nil, err
+	}
+	return ir, nil
+}
+
+// GetInvoiceInput is used as input to the GetInvoice function.
+type GetInvoiceInput struct {
+	// InvoiceID is the ID of the invoice (required).
+	InvoiceID string
+}
+
+// GetInvoice returns a single invoice, including its line items.
+func (c *Client) GetInvoice(i *GetInvoiceInput) (*Invoice, error) {
+	if i.InvoiceID == "" {
+		return nil, ErrMissingInvoiceID
+	}
+
+	path := fmt.Sprintf("/billing/v3/invoices/%s", url.PathEscape(i.InvoiceID))
+	return c.getInvoice(path)
+}
+
+// GetMonthToDateInvoice returns the charges of the current billing period
+// incurred so far, as an invoice.
+func (c *Client) GetMonthToDateInvoice() (*Invoice, error) {
+	return c.getInvoice("/billing/v3/invoices/month-to-date")
+}
+
+// getInvoice fetches and decodes the invoice at the given path.
+func (c *Client) getInvoice(path string) (*Invoice, error) {
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var inv *Invoice
+	if err := decodeBodyMap(resp.Body, &inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// NewListInvoicesPaginator returns a paginator over the invoices matching the
+// input, following the cursor returned with each page. Iteration starts at
+// i.Cursor, if set.
+func (c *Client) NewListInvoicesPaginator(i *ListInvoicesInput) PaginatorInvoices {
+	input := *i
+	return &cursorPaginator[*Invoice]{
+		cursor: i.Cursor,
+		fetch: func(cursor string) ([]*Invoice, string, error) {
+			input.Cursor = cursor
+			o, err := c.ListInvoices(&input)
+			if err != nil {
+				return nil, "", err
+			}
+			return o.Data, o.Meta.NextCursor, nil
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package fastly
+
+import "testing"
+
+func TestClient_Invoices(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "invoices/"
+
+	// List all pages
+	var invoices []*Invoice
+	var err error
+	record(t, fixtureBase+"list", func(c *Client) {
+		p := c.NewListInvoicesPaginator(&ListInvoicesInput{
+			Limit: 1,
+		})
+		for p.HasNext() {
+			var page []*Invoice
+			page, err = p.GetNext()
+			if err != nil {
+				return
+			}
+			invoices = append(invoices, page...)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invoices) != 2 || invoices[0].InvoiceID != "100" || invoices[1].InvoiceID != "101" {
+		t.Fatalf("bad invoices: %+v", invoices)
+	}
+
+	// Get
+	var inv *Invoice
+	record(t, fixtureBase+"get", func(c *Client) {
+		inv, err = c.GetInvoice(&GetInvoiceInput{
+			InvoiceID: "100",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.MonthlyTransactionAmount != 120.5 || inv.CurrencyCode != "USD" {
+		t.Errorf("bad invoice: %+v", inv)
+	}
+	if inv.BillingStartDate == nil || inv.BillingStartDate.Month() != 1 {
+		t.Errorf("bad billing start date: %v", inv.BillingStartDate)
+	}
+	if len(inv.LineItems) != 2 || inv.LineItems[0].Description != "Bandwidth" || inv.LineItems[1].Amount != 20 {
+		t.Errorf("bad line items: %+v", inv.LineItems)
+	}
+
+	// Month to date
+	record(t, fixtureBase+"month_to_date", func(c *Client) {
+		inv, err = c.GetMonthToDateInvoice()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.MonthlyTransactionAmount != 12 || len(inv.LineItems) != 1 {
+		t.Errorf("bad month to date invoice: %+v", inv)
+	}
+}
+
+func TestClient_GetInvoice_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetInvoice(&GetInvoiceInput{})
+	if err != ErrMissingInvoiceID {
+		t.Errorf("bad error: %s", err)
+	}
+}
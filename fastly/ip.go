@@ -3,19 +3,35 @@ package fastly
 // IPAddrs is a sortable list of IP addresses returned by the Fastly API.
 type IPAddrs []string
 
+// publicIPList is the response of the /public-ip-list endpoint.
+type publicIPList struct {
+	Addresses     IPAddrs `mapstructure:"addresses"`
+	IPv6Addresses IPAddrs `mapstructure:"ipv6_addresses"`
+}
+
 // AllIPs returns the lists of public IPv4 and IPv6 addresses for Fastly's network.
+//
+// The response is cached when the client was created with WithCache.
 func (c *Client) AllIPs() (v4, v6 IPAddrs, err error) {
-	resp, err := c.Get("/public-ip-list", nil)
+	v, err := c.cache.get("/public-ip-list", func() (interface{}, error) {
+		resp, err := c.Get("/public-ip-list", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var l *publicIPList
+		if err := decodeBodyMap(resp.Body, &l); err != nil {
+			return nil, err
+		}
+		return l, nil
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var m map[string][]string
-	if err := decodeBodyMap(resp.Body, &m); err != nil {
-		return nil, nil, err
-	}
-
-	return m["addresses"], m["ipv6_addresses"], nil
+	// Return copies so that sorting the result does not modify the cache.
+	l := v.(*publicIPList)
+	return append(IPAddrs(nil), l.Addresses...), append(IPAddrs(nil), l.IPv6Addresses...), nil
 }
 
 // IPs returns the list of public IPv4 addresses for Fastly's network.
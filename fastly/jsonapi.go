@@ -0,0 +1,45 @@
+package fastly
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/google/jsonapi"
+)
+
+// The helpers below let resources the package does not cover yet be used in
+// the same way as the built-in ones. Structs are described with `jsonapi`
+// tags (see github.com/google/jsonapi) and sent with PostJSONAPI,
+// PatchJSONAPI or Client.Do; responses are decoded with UnmarshalJSONAPI or
+// UnmarshalJSONAPIMany.
+
+// UnmarshalJSONAPI decodes a JSON:API document holding a single resource into
+// out, which must be a pointer to a jsonapi-tagged struct.
+func UnmarshalJSONAPI(body io.Reader, out interface{}) error {
+	return jsonapi.UnmarshalPayload(body, out)
+}
+
+// UnmarshalJSONAPIMany decodes a JSON:API document holding a list of
+// resources of the jsonapi-tagged struct type T.
+func UnmarshalJSONAPIMany[T any](body io.Reader) ([]*T, error) {
+	return decodeMany[T](body)
+}
+
+// decodeMany decodes a JSON:API list of resources of type T.
+func decodeMany[T any](body io.Reader) ([]*T, error) {
+	data, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(T)))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*T, len(data))
+	for i := range data {
+		typed, ok := data[i].(*T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type: %T", data[i])
+		}
+		out[i] = typed
+	}
+	return out, nil
+}
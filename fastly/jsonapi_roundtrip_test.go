@@ -0,0 +1,283 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/jsonapi"
+)
+
+// jsonapiTypes lists every jsonapi-tagged struct of the package, which
+// TestJSONAPI_roundTrip sends to and reads back from a fake server to catch
+// malformed or mistyped tags. TestJSONAPI_allTypesCovered fails when a new
+// struct is missing from the list.
+var jsonapiTypes = []interface{}{
+	new(BulkCertificate),
+	new(ConfigurationSetWAF),
+	new(CreateCustomTLSCertificateInput),
+	new(CreateServiceAuthorizationInput),
+	new(CreateTLSActivationInput),
+	new(CreateTLSSubscriptionInput),
+	new(CreateWAFInput),
+	new(CustomTLSCertificate),
+	new(CustomTLSConfiguration),
+	new(DNSRecord),
+	new(DeleteWAFInput),
+	new(DomainOwnership),
+	new(Event),
+	new(PrivateKey),
+	new(SAService),
+	new(SAUser),
+	new(BatchServiceAuthorization),
+	new(BatchToken),
+	new(ServiceAuthorization),
+	new(Star),
+	new(TLSActivation),
+	new(TLSAuthorizations),
+	new(TLSConfiguration),
+	new(TLSDomain),
+	new(TLSSubscription),
+	new(TLSSubscriptionCertificate),
+	new(UpdateCustomTLSCertificateInput),
+	new(UpdateServiceAuthorizationInput),
+	new(UpdateTLSActivationInput),
+	new(UpdateTLSSubscriptionInput),
+	new(UpdateWAFInput),
+	new(UpdateWAFVersionInput),
+	new(WAF),
+	new(WAFActiveRule),
+	new(WAFConfigurationSet),
+	new(WAFRule),
+	new(WAFRuleExclusion),
+	new(WAFRuleRevision),
+	new(WAFRuleset),
+	new(WAFTag),
+	new(WAFUpdateStatus),
+	new(WAFVersion),
+	new(createStarPayload),
+}
+
+func TestJSONAPI_allTypesCovered(t *testing.T) {
+	t.Parallel()
+
+	covered := make(map[string]bool)
+	for _, v := range jsonapiTypes {
+		covered[reflect.TypeOf(v).Elem().Name()] = true
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range pkgs["fastly"].Files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range st.Fields.List {
+				if field.Tag != nil && strings.Contains(field.Tag.Value, `jsonapi:"primary`) {
+					if !covered[ts.Name.Name] {
+						t.Errorf("%s has jsonapi tags but is missing from jsonapiTypes", ts.Name.Name)
+					}
+					break
+				}
+			}
+			return true
+		})
+	}
+}
+
+func TestJSONAPI_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	// The fake server echoes the payload it receives.
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", jsonapi.MediaType)
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range jsonapiTypes {
+		typ := reflect.TypeOf(v).Elem()
+		t.Run(typ.Name(), func(t *testing.T) {
+			in := reflect.New(typ)
+			fillJSONAPI(in.Elem(), 0)
+
+			resp, err := c.PostJSONAPI("/echo", in.Interface(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sent, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Every tagged field must be encoded.
+			var doc struct {
+				Data struct {
+					ID            string                     `json:"id"`
+					Attributes    map[string]json.RawMessage `json:"attributes"`
+					Relationships map[string]json.RawMessage `json:"relationships"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(sent, &doc); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < typ.NumField(); i++ {
+				args := strings.Split(typ.Field(i).Tag.Get("jsonapi"), ",")
+				switch args[0] {
+				case "primary":
+					if doc.Data.ID == "" {
+						t.Errorf("%s: primary ID not encoded", typ.Field(i).Name)
+					}
+				case "attr":
+					if _, ok := doc.Data.Attributes[args[1]]; !ok {
+						t.Errorf("%s: attribute %q not encoded", typ.Field(i).Name, args[1])
+					}
+				case "relation":
+					if _, ok := doc.Data.Relationships[args[1]]; !ok {
+						t.Errorf("%s: relationship %q not encoded", typ.Field(i).Name, args[1])
+					}
+				}
+			}
+
+			// Decoding the payload and encoding it again must give the
+			// same payload.
+			out := reflect.New(typ)
+			if err := jsonapi.UnmarshalPayload(bytes.NewReader(sent), out.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			var again bytes.Buffer
+			if err := jsonapi.MarshalPayload(&again, out.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			if a, b := normalizeJSONAPI(t, sent), normalizeJSONAPI(t, again.Bytes()); a != b {
+				t.Errorf("payload changed in round trip:\nsent: %s\ngot:  %s", a, b)
+			}
+		})
+	}
+}
+
+// normalizeJSONAPI returns the payload with its included resources, which are
+// encoded in random order, sorted.
+func normalizeJSONAPI(t *testing.T, payload []byte) string {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(payload, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if included, ok := doc["included"].([]interface{}); ok {
+		key := func(i int) string {
+			r := included[i].(map[string]interface{})
+			return fmt.Sprint(r["type"], "/", r["id"])
+		}
+		sort.Slice(included, func(i, j int) bool { return key(i) < key(j) })
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// fillJSONAPI sets every jsonapi-tagged field of the struct v to a non-zero
+// value. Relations are only filled at the top level.
+func fillJSONAPI(v reflect.Value, depth int) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		args := strings.Split(typ.Field(i).Tag.Get("jsonapi"), ",")
+		if len(args) < 2 {
+			continue
+		}
+		f := v.Field(i)
+		switch args[0] {
+		case "primary":
+			fillValue(f, "id-"+strings.ToLower(typ.Name()))
+		case "attr":
+			fillValue(f, args[1])
+		case "relation":
+			if depth > 0 {
+				continue
+			}
+			if f.Kind() == reflect.Slice {
+				s := reflect.MakeSlice(f.Type(), 1, 1)
+				s.Index(0).Set(reflect.New(f.Type().Elem().Elem()))
+				fillJSONAPI(s.Index(0).Elem(), depth+1)
+				f.Set(s)
+			} else {
+				f.Set(reflect.New(f.Type().Elem()))
+				fillJSONAPI(f.Elem(), depth+1)
+			}
+		}
+	}
+}
+
+// fillValue sets v to a non-zero sample value of its type.
+func fillValue(v reflect.Value, name string) {
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		v.Set(reflect.ValueOf(time.Date(2022, 6, 20, 9, 5, 32, 0, time.UTC)))
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem(), name)
+	case reflect.String:
+		v.SetString("value-" + name)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(7)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(7)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 1, 1)
+		fillValue(s.Index(0), name)
+		v.Set(s)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		k := reflect.New(v.Type().Key()).Elem()
+		fillValue(k, name)
+		e := reflect.New(v.Type().Elem()).Elem()
+		fillValue(e, name)
+		m.SetMapIndex(k, e)
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillValue(v.Field(i), v.Type().Field(i).Name)
+			}
+		}
+	case reflect.Interface:
+		v.Set(reflect.ValueOf("value-" + name))
+	}
+}
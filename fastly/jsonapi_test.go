@@ -0,0 +1,77 @@
+package fastly
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/jsonapi"
+)
+
+type testWidget struct {
+	ID   string `jsonapi:"primary,widget"`
+	Name string `jsonapi:"attr,name"`
+}
+
+func TestJSONAPIHelpers(t *testing.T) {
+	t.Parallel()
+
+	var contentType string
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", jsonapi.MediaType)
+		switch r.Method {
+		case http.MethodPost:
+			contentType = r.Header.Get("Content-Type")
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"data":{"type":"widget","id":"w1","attributes":{"name":"one"}}}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"data":[
+				{"type":"widget","id":"w1","attributes":{"name":"one"}},
+				{"type":"widget","id":"w2","attributes":{"name":"two"}}
+			]}`))
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.PostJSONAPI("/widgets", &testWidget{Name: "one"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if contentType != jsonapi.MediaType {
+		t.Errorf("bad content type: %q", contentType)
+	}
+	if data, ok := sent["data"].(map[string]interface{}); !ok || data["type"] != "widget" {
+		t.Errorf("bad request body: %v", sent)
+	}
+
+	var w testWidget
+	if err := UnmarshalJSONAPI(resp.Body, &w); err != nil {
+		t.Fatal(err)
+	}
+	if w.ID != "w1" || w.Name != "one" {
+		t.Errorf("bad widget: %+v", w)
+	}
+
+	resp, err = c.Get("/widgets", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	ws, err := UnmarshalJSONAPIMany[testWidget](resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws) != 2 || ws[0].ID != "w1" || ws[1].Name != "two" {
+		t.Errorf("bad widgets: %+v", ws)
+	}
+}
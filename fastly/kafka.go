@@ -87,25 +87,25 @@ type CreateKafkaInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	Name              string      `url:"name,omitempty"`
-	Brokers           string      `url:"brokers,omitempty"`
-	Topic             string      `url:"topic,omitempty"`
-	RequiredACKs      string      `url:"required_acks,omitempty"`
-	UseTLS            Compatibool `url:"use_tls,omitempty"`
-	CompressionCodec  string      `url:"compression_codec,omitempty"`
-	Format            string      `url:"format,omitempty"`
-	FormatVersion     uint        `url:"format_version,omitempty"`
-	ResponseCondition string      `url:"response_condition,omitempty"`
-	Placement         string      `url:"placement,omitempty"`
-	TLSCACert         string      `url:"tls_ca_cert,omitempty"`
-	TLSHostname       string      `url:"tls_hostname,omitempty"`
-	TLSClientCert     string      `url:"tls_client_cert,omitempty"`
-	TLSClientKey      string      `url:"tls_client_key,omitempty"`
-	ParseLogKeyvals   Compatibool `url:"parse_log_keyvals,omitempty"`
-	RequestMaxBytes   uint        `url:"request_max_bytes,omitempty"`
-	AuthMethod        string      `url:"auth_method,omitempty"`
-	User              string      `url:"user,omitempty"`
-	Password          string      `url:"password,omitempty"`
+	Name              string `url:"name,omitempty"`
+	Brokers           string `url:"brokers,omitempty"`
+	Topic             string `url:"topic,omitempty"`
+	RequiredACKs      string `url:"required_acks,omitempty"`
+	UseTLS            *bool  `url:"use_tls,omitempty,int"`
+	CompressionCodec  string `url:"compression_codec,omitempty"`
+	Format            string `url:"format,omitempty"`
+	FormatVersion     uint   `url:"format_version,omitempty"`
+	ResponseCondition string `url:"response_condition,omitempty"`
+	Placement         string `url:"placement,omitempty"`
+	TLSCACert         string `url:"tls_ca_cert,omitempty"`
+	TLSHostname       string `url:"tls_hostname,omitempty"`
+	TLSClientCert     string `url:"tls_client_cert,omitempty"`
+	TLSClientKey      string `url:"tls_client_key,omitempty"`
+	ParseLogKeyvals   *bool  `url:"parse_log_keyvals,omitempty,int"`
+	RequestMaxBytes   uint   `url:"request_max_bytes,omitempty"`
+	AuthMethod        string `url:"auth_method,omitempty"`
+	User              string `url:"user,omitempty"`
+	Password          string `url:"password,omitempty"`
 }
 
 // CreateKafka creates a new Fastly kafka.
@@ -181,25 +181,25 @@ type UpdateKafkaInput struct {
 	// Name is the name of the kafka to update.
 	Name string
 
-	NewName           *string      `url:"name,omitempty"`
-	Brokers           *string      `url:"brokers,omitempty"`
-	Topic             *string      `url:"topic,omitempty"`
-	RequiredACKs      *string      `url:"required_acks,omitempty"`
-	UseTLS            *Compatibool `url:"use_tls,omitempty"`
-	CompressionCodec  *string      `url:"compression_codec,omitempty"`
-	Format            *string      `url:"format,omitempty"`
-	FormatVersion     *uint        `url:"format_version,omitempty"`
-	ResponseCondition *string      `url:"response_condition,omitempty"`
-	Placement         *string      `url:"placement,omitempty"`
-	TLSCACert         *string      `url:"tls_ca_cert,omitempty"`
-	TLSHostname       *string      `url:"tls_hostname,omitempty"`
-	TLSClientCert     *string      `url:"tls_client_cert,omitempty"`
-	TLSClientKey      *string      `url:"tls_client_key,omitempty"`
-	ParseLogKeyvals   *Compatibool `url:"parse_log_keyvals,omitempty"`
-	RequestMaxBytes   *uint        `url:"request_max_bytes,omitempty"`
-	AuthMethod        *string      `url:"auth_method,omitempty"`
-	User              *string      `url:"user,omitempty"`
-	Password          *string      `url:"password,omitempty"`
+	NewName           *string `url:"name,omitempty"`
+	Brokers           *string `url:"brokers,omitempty"`
+	Topic             *string `url:"topic,omitempty"`
+	RequiredACKs      *string `url:"required_acks,omitempty"`
+	UseTLS            *bool   `url:"use_tls,omitempty,int"`
+	CompressionCodec  *string `url:"compression_codec,omitempty"`
+	Format            *string `url:"format,omitempty"`
+	FormatVersion     *uint   `url:"format_version,omitempty"`
+	ResponseCondition *string `url:"response_condition,omitempty"`
+	Placement         *string `url:"placement,omitempty"`
+	TLSCACert         *string `url:"tls_ca_cert,omitempty"`
+	TLSHostname       *string `url:"tls_hostname,omitempty"`
+	TLSClientCert     *string `url:"tls_client_cert,omitempty"`
+	TLSClientKey      *string `url:"tls_client_key,omitempty"`
+	ParseLogKeyvals   *bool   `url:"parse_log_keyvals,omitempty,int"`
+	RequestMaxBytes   *uint   `url:"request_max_bytes,omitempty"`
+	AuthMethod        *string `url:"auth_method,omitempty"`
+	User              *string `url:"user,omitempty"`
+	Password          *string `url:"password,omitempty"`
 }
 
 // UpdateKafka updates a specific kafka.
@@ -30,7 +30,7 @@ func TestClient_Kafkas(t *testing.T) {
 			Brokers:          "192.168.1.1,192.168.1.2",
 			Topic:            "kafka-topic",
 			RequiredACKs:     "-1",
-			UseTLS:           true,
+			UseTLS:           Bool(true),
 			CompressionCodec: "lz4",
 			Format:           "%h %l %u %t \"%r\" %>s %b",
 			FormatVersion:    2,
@@ -39,7 +39,7 @@ func TestClient_Kafkas(t *testing.T) {
 			TLSHostname:      "example.com",
 			TLSClientCert:    clientCert,
 			TLSClientKey:     clientKey,
-			ParseLogKeyvals:  true,
+			ParseLogKeyvals:  Bool(true),
 			RequestMaxBytes:  requestMaxBytes,
 			AuthMethod:       "scram-sha-512",
 			User:             "foobar",
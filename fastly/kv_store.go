@@ -0,0 +1,187 @@
+package fastly
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+)
+
+// KVStoreConsistency is the consistency mode of a KV Store key listing.
+type KVStoreConsistency string
+
+const (
+	// KVStoreConsistencyStrong lists keys as of the latest write.
+	KVStoreConsistencyStrong KVStoreConsistency = "strong"
+	// KVStoreConsistencyEventual lists keys from a cache that may lag
+	// recent writes, in exchange for faster responses.
+	KVStoreConsistencyEventual KVStoreConsistency = "eventual"
+)
+
+// KVStoreBatchEntry is a single line of the newline-delimited JSON body sent
+// to BatchModifyKVStoreKeys. Callers may encode these with a json.Encoder
+// writing to the batch body, or produce the lines in any other way.
+type KVStoreBatchEntry struct {
+	// Key is the name of the key to write (required).
+	Key string `json:"key"`
+	// Value is the base64 encoded value of the key (required).
+	Value string `json:"value"`
+	// Metadata is arbitrary data associated with the key.
+	Metadata string `json:"metadata,omitempty"`
+	// TimeToLiveSec, if set, expires the key after the given number of seconds.
+	TimeToLiveSec int `json:"time_to_live_sec,omitempty"`
+	// Add, Append and Prepend change how the value is written when the key
+	// already exists.
+	Add     bool `json:"add,omitempty"`
+	Append  bool `json:"append,omitempty"`
+	Prepend bool `json:"prepend,omitempty"`
+}
+
+// BatchModifyKVStoreKeysInput is the input to the BatchModifyKVStoreKeys function.
+type BatchModifyKVStoreKeysInput struct {
+	// StoreID is the ID of the KV Store (required).
+	StoreID string
+	// Body is the newline-delimited JSON stream of KVStoreBatchEntry
+	// objects to write (required).
+	Body io.Reader
+}
+
+// BatchModifyKVStoreKeys streams a newline-delimited JSON body of key/value
+// entries to the KV Store bulk endpoint. The body is read as the request is
+// sent and is never buffered in full, so arbitrarily large datasets can be
+// loaded. A Body that cannot be rewound (anything other than a bytes.Buffer,
+// bytes.Reader or strings.Reader) means the request is not retried.
+func (c *Client) BatchModifyKVStoreKeys(i *BatchModifyKVStoreKeysInput) error {
+	if i.StoreID == "" {
+		return ErrMissingStoreID
+	}
+
+	if i.Body == nil {
+		return ErrMissingBody
+	}
+
+	path := fmt.Sprintf("/resources/stores/kv/%s/batch", url.PathEscape(i.StoreID))
+	resp, err := c.Put(path, &RequestOptions{
+		Body: i.Body,
+		Headers: map[string]string{
+			"Content-Type": "application/x-ndjson",
+			"Accept":       "application/json",
+		},
+		Parallel: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetKVStoreKeyInput is the input to the GetKVStoreKey function.
+type GetKVStoreKeyInput struct {
+	// StoreID is the ID of the KV Store (required).
+	StoreID string
+	// Key is the name of the key to read (required).
+	Key string
+}
+
+// GetKVStoreKey streams the value of a key in a KV Store. The value is not
+// buffered in memory, so values of any size can be read. The caller must close
+// the returned reader.
+func (c *Client) GetKVStoreKey(i *GetKVStoreKeyInput) (io.ReadCloser, error) {
+	if i.StoreID == "" {
+		return nil, ErrMissingStoreID
+	}
+
+	if i.Key == "" {
+		return nil, ErrMissingKey
+	}
+
+	path := fmt.Sprintf("/resources/stores/kv/%s/keys/%s", url.PathEscape(i.StoreID), url.PathEscape(i.Key))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// ListKVStoreKeysInput is the input to the ListKVStoreKeys function.
+type ListKVStoreKeysInput struct {
+	// StoreID is the ID of the KV Store (required).
+	StoreID string
+	// Cursor is the value of NextCursor from a previous page (optional).
+	Cursor string
+	// Limit is the maximum number of keys to return per page (optional).
+	Limit int
+	// Prefix limits the returned keys to those starting with it (optional).
+	Prefix string
+	// Consistency selects between strong and eventual consistency (optional).
+	Consistency KVStoreConsistency
+}
+
+// ListKVStoreKeysMeta holds the cursor pagination details of a page of keys.
+type ListKVStoreKeysMeta struct {
+	NextCursor string `mapstructure:"next_cursor"`
+	Limit      int    `mapstructure:"limit"`
+}
+
+// ListKVStoreKeysResponse is a page of KV Store keys.
+type ListKVStoreKeysResponse struct {
+	Data []string            `mapstructure:"data"`
+	Meta ListKVStoreKeysMeta `mapstructure:"meta"`
+}
+
+// ListKVStoreKeys returns a single page of the keys in a KV Store. Use
+// NewListKVStoreKeysPaginator to iterate over all of the keys.
+func (c *Client) ListKVStoreKeys(i *ListKVStoreKeysInput) (*ListKVStoreKeysResponse, error) {
+	if i.StoreID == "" {
+		return nil, ErrMissingStoreID
+	}
+
+	ro := &RequestOptions{
+		Params: map[string]string{},
+	}
+	if i.Cursor != "" {
+		ro.Params["cursor"] = i.Cursor
+	}
+	if i.Limit != 0 {
+		ro.Params["limit"] = strconv.Itoa(i.Limit)
+	}
+	if i.Prefix != "" {
+		ro.Params["prefix"] = i.Prefix
+	}
+	if i.Consistency != "" {
+		ro.Params["consistency"] = string(i.Consistency)
+	}
+
+	path := fmt.Sprintf("/resources/stores/kv/%s/keys", url.PathEscape(i.StoreID))
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var output *ListKVStoreKeysResponse
+	if err := decodeBodyMap(resp.Body, &output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// NewListKVStoreKeysPaginator returns a paginator over the keys of a KV Store
+// matching the input, following the cursor returned with each page. Iteration
+// starts at i.Cursor, if set.
+func (c *Client) NewListKVStoreKeysPaginator(i *ListKVStoreKeysInput) PaginatorKVStoreKeys {
+	input := *i
+	return &cursorPaginator[string]{
+		cursor: i.Cursor,
+		fetch: func(cursor string) ([]string, string, error) {
+			input.Cursor = cursor
+			o, err := c.ListKVStoreKeys(&input)
+			if err != nil {
+				return nil, "", err
+			}
+			return o.Data, o.Meta.NextCursor, nil
+		},
+	}
+}
@@ -0,0 +1,209 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_BatchModifyKVStoreKeys(t *testing.T) {
+	t.Parallel()
+
+	var contentType string
+	var got []KVStoreBatchEntry
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/resources/stores/kv/store-id/batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		contentType = r.Header.Get("Content-Type")
+		dec := json.NewDecoder(r.Body)
+		for dec.More() {
+			var e KVStoreBatchEntry
+			if err := dec.Decode(&e); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			got = append(got, e)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stream the entries through a pipe so that the body is never held in
+	// memory as a whole.
+	pr, pw := io.Pipe()
+	go func() {
+		enc := json.NewEncoder(pw)
+		for _, e := range []KVStoreBatchEntry{
+			{Key: "a", Value: "MQ=="},
+			{Key: "b", Value: "Mg==", Metadata: "meta", TimeToLiveSec: 60},
+		} {
+			if err := enc.Encode(e); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+
+	if err := c.BatchModifyKVStoreKeys(&BatchModifyKVStoreKeysInput{
+		StoreID: "store-id",
+		Body:    pr,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if contentType != "application/x-ndjson" {
+		t.Errorf("bad content type: %q", contentType)
+	}
+	if len(got) != 2 || got[0].Key != "a" || got[1].Metadata != "meta" || got[1].TimeToLiveSec != 60 {
+		t.Errorf("bad entries: %+v", got)
+	}
+}
+
+func TestClient_BatchModifyKVStoreKeys_validation(t *testing.T) {
+	var err error
+	err = testClient.BatchModifyKVStoreKeys(&BatchModifyKVStoreKeysInput{
+		StoreID: "",
+	})
+	if err != ErrMissingStoreID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyKVStoreKeys(&BatchModifyKVStoreKeysInput{
+		StoreID: "store-id",
+	})
+	if err != ErrMissingBody {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetKVStoreKey(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.EscapedPath() != "/resources/stores/kv/store-id/keys/user%2Fa" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, "value")
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := c.GetKVStoreKey(&GetKVStoreKeyInput{
+		StoreID: "store-id",
+		Key:     "user/a",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	value, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != "value" {
+		t.Errorf("bad value: %q", value)
+	}
+
+	_, err = c.GetKVStoreKey(&GetKVStoreKeyInput{
+		StoreID: "store-id",
+		Key:     "missing",
+	})
+	if herr, ok := err.(*HTTPError); !ok || !herr.IsNotFound() {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_GetKVStoreKey_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetKVStoreKey(&GetKVStoreKeyInput{
+		StoreID: "",
+	})
+	if err != ErrMissingStoreID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetKVStoreKey(&GetKVStoreKeyInput{
+		StoreID: "store-id",
+	})
+	if err != ErrMissingKey {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListKVStoreKeys(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/resources/stores/kv/store-id/keys" || q.Get("prefix") != "user/" || q.Get("limit") != "2" || q.Get("consistency") != "strong" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch q.Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{"data":["user/a","user/b"],"meta":{"next_cursor":"page2","limit":2}}`)
+		case "page2":
+			fmt.Fprint(w, `{"data":["user/c"],"meta":{"limit":2}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pages [][]string
+	p := c.NewListKVStoreKeysPaginator(&ListKVStoreKeysInput{
+		StoreID:     "store-id",
+		Prefix:      "user/",
+		Limit:       2,
+		Consistency: KVStoreConsistencyStrong,
+	})
+	for p.HasNext() {
+		keys, err := p.GetNext()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, keys)
+	}
+	if fmt.Sprint(pages) != "[[user/a user/b] [user/c]]" {
+		t.Errorf("bad pages: %v", pages)
+	}
+
+	p = c.NewListKVStoreKeysPaginator(&ListKVStoreKeysInput{
+		StoreID: "store-id",
+	})
+	if _, err := p.GetNext(); err == nil {
+		t.Error("expected an error")
+	} else if herr, ok := err.(*HTTPError); !ok || !herr.IsNotFound() {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ListKVStoreKeys_validation(t *testing.T) {
+	_, err := testClient.ListKVStoreKeys(&ListKVStoreKeysInput{
+		StoreID: "",
+	})
+	if err != ErrMissingStoreID {
+		t.Errorf("bad error: %s", err)
+	}
+}
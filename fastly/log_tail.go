@@ -0,0 +1,131 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// LogTailSortAsc returns log lines oldest first.
+	LogTailSortAsc LogTailSort = "asc"
+
+	// LogTailSortDesc returns log lines newest first.
+	LogTailSortDesc LogTailSort = "desc"
+)
+
+// LogTailHost is the host serving the log tail sessions returned by
+// CreateLogTailSession. GetLogTailBatch only sends the client's API key to
+// this host and to the host of the client's API endpoint.
+var LogTailHost = "rt.fastly.com"
+
+// LogTailSort is the order in which a LogTailBatch returns log lines.
+type LogTailSort string
+
+// LogTailSession is a session for streaming the stdout and stderr output of a
+// Compute@Edge service. Managed logging of the ManagedLoggingInstanceOutput
+// kind has to be enabled for the service, see CreateManagedLogging.
+type LogTailSession struct {
+	// URL is polled with GetLogTailBatch to receive log lines.
+	URL       string     `mapstructure:"url"`
+	ExpiresAt *time.Time `mapstructure:"expires_at"`
+}
+
+// LogTailLine is a single line written by a Compute@Edge service instance.
+type LogTailLine struct {
+	SequenceNumber int64  `mapstructure:"seq"`
+	RequestID      string `mapstructure:"request_id"`
+	// RequestTime is the start of the request in microseconds since the Unix
+	// epoch.
+	RequestTime int64 `mapstructure:"request_start_time"`
+	// Stream is either "stdout" or "stderr".
+	Stream  string `mapstructure:"stream"`
+	Message string `mapstructure:"data"`
+}
+
+// LogTailBatch is a batch of log lines returned by GetLogTailBatch.
+type LogTailBatch struct {
+	ID   string         `mapstructure:"id"`
+	Logs []*LogTailLine `mapstructure:"logs"`
+	// Highwater is the position after the last line of the batch. It is
+	// passed as GetLogTailBatchInput.From to receive the following lines.
+	Highwater int64 `mapstructure:"highwater"`
+}
+
+// CreateLogTailSessionInput is used as input to the CreateLogTailSession function.
+type CreateLogTailSessionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// CreateLogTailSession creates a session for tailing the instance output of a
+// Compute@Edge service.
+func (c *Client) CreateLogTailSession(i *CreateLogTailSessionInput) (*LogTailSession, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	path := fmt.Sprintf("/service/%s/log_stream/managed/instance_output/tail", i.ServiceID)
+	resp, err := c.Post(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *LogTailSession
+	if err := decodeBodyMap(resp.Body, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetLogTailBatchInput is used as input to the GetLogTailBatch function.
+type GetLogTailBatchInput struct {
+	// URL is the URL of the LogTailSession (required).
+	URL string
+	// From is the position to return lines from, usually the Highwater of the
+	// previous batch (optional).
+	From int64
+	// Sort is the order of the returned lines (optional).
+	Sort LogTailSort
+}
+
+// GetLogTailBatch polls a log tail session for the next batch of log lines.
+// The client's API key is only sent when the session URL is on the API host
+// or the LogTailHost, so that it is not leaked to other hosts.
+func (c *Client) GetLogTailBatch(i *GetLogTailBatchInput) (*LogTailBatch, error) {
+	if i.URL == "" {
+		return nil, ErrMissingURL
+	}
+
+	u, err := url.Parse(i.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	ro := &RequestOptions{
+		Params:   map[string]string{},
+		endpoint: &url.URL{Scheme: u.Scheme, Host: u.Host},
+		noAPIKey: u.Host != c.url.Host && u.Host != LogTailHost,
+	}
+	for k, v := range u.Query() {
+		ro.Params[k] = v[0]
+	}
+	if i.From != 0 {
+		ro.Params["from"] = strconv.FormatInt(i.From, 10)
+	}
+	if i.Sort != "" {
+		ro.Params["sort"] = string(i.Sort)
+	}
+
+	resp, err := c.Get(u.Path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *LogTailBatch
+	if err := decodeBodyMap(resp.Body, &b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,95 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_LogTail(t *testing.T) {
+	t.Parallel()
+
+	var query, key string
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/service-id/log_stream/managed/instance_output/tail":
+			fmt.Fprintf(w, `{"url":"%s/tail/session-id?token=secret","expires_at":"2022-06-20T10:05:32Z"}`, ts.URL)
+		case r.Method == http.MethodGet && r.URL.Path == "/tail/session-id":
+			query = r.URL.RawQuery
+			key = r.Header.Get(APIKeyHeader)
+			fmt.Fprint(w, `{"id":"batch-id","highwater":42,"logs":[`+
+				`{"seq":41,"request_id":"req-1","request_start_time":1655719532000000,"stream":"stdout","data":"hello"},`+
+				`{"seq":42,"request_id":"req-1","request_start_time":1655719532000000,"stream":"stderr","data":"oops"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("api-key", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := c.CreateLogTailSession(&CreateLogTailSessionInput{ServiceID: "service-id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ExpiresAt == nil {
+		t.Errorf("missing expires_at")
+	}
+
+	b, err := c.GetLogTailBatch(&GetLogTailBatchInput{
+		URL:  s.URL,
+		From: 40,
+		Sort: LogTailSortAsc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query != "from=40&sort=asc&token=secret" {
+		t.Errorf("bad query: %s", query)
+	}
+	if key != "api-key" {
+		t.Errorf("expected the API key to be sent to the API host, got %q", key)
+	}
+	if b.Highwater != 42 || len(b.Logs) != 2 {
+		t.Fatalf("bad batch: %+v", b)
+	}
+	if l := b.Logs[1]; l.SequenceNumber != 42 || l.Stream != "stderr" || l.Message != "oops" || l.RequestID != "req-1" {
+		t.Errorf("bad line: %+v", l)
+	}
+
+	// The API key is not sent to other hosts.
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get(APIKeyHeader)
+		fmt.Fprint(w, `{"id":"batch-id","highwater":42,"logs":[]}`)
+	}))
+	defer other.Close()
+
+	key = ""
+	_, err = c.GetLogTailBatch(&GetLogTailBatchInput{
+		URL: other.URL + "/tail/session-id?token=secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "" {
+		t.Errorf("expected no API key to be sent to another host, got %q", key)
+	}
+}
+
+func TestClient_LogTail_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateLogTailSession(&CreateLogTailSessionInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetLogTailBatch(&GetLogTailBatchInput{})
+	if err != ErrMissingURL {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -21,7 +21,7 @@ func TestClient_Logentries(t *testing.T) {
 			ServiceVersion: tv.Number,
 			Name:           "test-logentries",
 			Port:           0,
-			UseTLS:         true,
+			UseTLS:         Bool(true),
 			Token:          "abcd1234",
 			Format:         "format",
 			Placement:      "waf_debug",
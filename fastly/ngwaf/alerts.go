@@ -0,0 +1,175 @@
+package ngwaf
+
+import (
+	"time"
+)
+
+// Alert is a site alert, which triggers an action when a signal is seen more
+// than Threshold times within Interval minutes.
+type Alert struct {
+	ID                   string     `json:"id"`
+	TagName              string     `json:"tagName"`
+	LongName             string     `json:"longName"`
+	Interval             int        `json:"interval"`
+	Threshold            int        `json:"threshold"`
+	Enabled              bool       `json:"enabled"`
+	Action               string     `json:"action"`
+	BlockDurationSeconds int        `json:"blockDurationSeconds"`
+	SkipNotifications    bool       `json:"skipNotifications"`
+	CreatedBy            string     `json:"createdBy"`
+	Created              *time.Time `json:"created"`
+}
+
+// ListAlertsInput is used as input to the ListAlerts function.
+type ListAlertsInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site (required).
+	Site string
+}
+
+// ListAlerts returns the alerts of a site.
+func (c *Client) ListAlerts(i *ListAlertsInput) ([]*Alert, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Site == "" {
+		return nil, ErrMissingSite
+	}
+
+	var out struct {
+		Data []*Alert `json:"data"`
+	}
+	if err := c.request("GET", scopePath(i.Corp, i.Site, "alerts"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetAlertInput is used as input to the GetAlert function.
+type GetAlertInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site (required).
+	Site string
+	// ID is the ID of the alert (required).
+	ID string
+}
+
+// GetAlert returns an alert.
+func (c *Client) GetAlert(i *GetAlertInput) (*Alert, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Site == "" {
+		return nil, ErrMissingSite
+	}
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	var a *Alert
+	if err := c.request("GET", scopePath(i.Corp, i.Site, "alerts/"+i.ID), nil, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// CreateAlertInput is used as input to the CreateAlert function.
+type CreateAlertInput struct {
+	// Corp is the name of the corp (required).
+	Corp string `json:"-"`
+	// Site is the name of the site (required).
+	Site string `json:"-"`
+
+	// TagName is the name of the signal the alert counts (required).
+	TagName              string `json:"tagName"`
+	LongName             string `json:"longName,omitempty"`
+	Interval             int    `json:"interval"`
+	Threshold            int    `json:"threshold"`
+	Enabled              bool   `json:"enabled"`
+	Action               string `json:"action"`
+	BlockDurationSeconds int    `json:"blockDurationSeconds,omitempty"`
+	SkipNotifications    bool   `json:"skipNotifications,omitempty"`
+}
+
+// CreateAlert creates an alert.
+func (c *Client) CreateAlert(i *CreateAlertInput) (*Alert, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Site == "" {
+		return nil, ErrMissingSite
+	}
+	if i.TagName == "" {
+		return nil, ErrMissingTagName
+	}
+
+	var a *Alert
+	if err := c.request("POST", scopePath(i.Corp, i.Site, "alerts"), i, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UpdateAlertInput is used as input to the UpdateAlert function.
+type UpdateAlertInput struct {
+	// Corp is the name of the corp (required).
+	Corp string `json:"-"`
+	// Site is the name of the site (required).
+	Site string `json:"-"`
+	// ID is the ID of the alert (required).
+	ID string `json:"-"`
+
+	LongName             *string `json:"longName,omitempty"`
+	Interval             *int    `json:"interval,omitempty"`
+	Threshold            *int    `json:"threshold,omitempty"`
+	Enabled              *bool   `json:"enabled,omitempty"`
+	Action               *string `json:"action,omitempty"`
+	BlockDurationSeconds *int    `json:"blockDurationSeconds,omitempty"`
+	SkipNotifications    *bool   `json:"skipNotifications,omitempty"`
+}
+
+// UpdateAlert updates an alert.
+func (c *Client) UpdateAlert(i *UpdateAlertInput) (*Alert, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Site == "" {
+		return nil, ErrMissingSite
+	}
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	var a *Alert
+	if err := c.request("PATCH", scopePath(i.Corp, i.Site, "alerts/"+i.ID), i, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// DeleteAlertInput is used as input to the DeleteAlert function.
+type DeleteAlertInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site (required).
+	Site string
+	// ID is the ID of the alert (required).
+	ID string
+}
+
+// DeleteAlert deletes an alert.
+func (c *Client) DeleteAlert(i *DeleteAlertInput) error {
+	if i.Corp == "" {
+		return ErrMissingCorp
+	}
+	if i.Site == "" {
+		return ErrMissingSite
+	}
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	return c.request("DELETE", scopePath(i.Corp, i.Site, "alerts/"+i.ID), nil, nil)
+}
@@ -0,0 +1,100 @@
+package ngwaf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Alerts(t *testing.T) {
+	var update map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v0/corps/corp/sites/www/alerts":
+			var a *Alert
+			json.NewDecoder(r.Body).Decode(&a)
+			a.ID = "alert-id"
+			json.NewEncoder(w).Encode(a)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v0/corps/corp/sites/www/alerts":
+			fmt.Fprint(w, `{"data":[{"id":"alert-id","tagName":"SQLI","interval":1,"threshold":10,"action":"flagged"}]}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v0/corps/corp/sites/www/alerts/alert-id":
+			json.NewDecoder(r.Body).Decode(&update)
+			fmt.Fprint(w, `{"id":"alert-id","tagName":"SQLI","interval":1,"threshold":25,"action":"flagged"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v0/corps/corp/sites/www/alerts/alert-id":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	a, err := c.CreateAlert(&CreateAlertInput{
+		Corp:      "corp",
+		Site:      "www",
+		TagName:   "SQLI",
+		Interval:  1,
+		Threshold: 10,
+		Enabled:   true,
+		Action:    "flagged",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.ID != "alert-id" || a.TagName != "SQLI" || !a.Enabled {
+		t.Errorf("bad alert: %+v", a)
+	}
+
+	as, err := c.ListAlerts(&ListAlertsInput{Corp: "corp", Site: "www"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(as) != 1 || as[0].Threshold != 10 {
+		t.Errorf("bad alerts: %+v", as)
+	}
+
+	threshold := 25
+	a, err = c.UpdateAlert(&UpdateAlertInput{
+		Corp:      "corp",
+		Site:      "www",
+		ID:        a.ID,
+		Threshold: &threshold,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(update) != 1 || update["threshold"] != float64(25) {
+		t.Errorf("bad update body: %v", update)
+	}
+	if a.Threshold != 25 {
+		t.Errorf("bad alert: %+v", a)
+	}
+
+	if err := c.DeleteAlert(&DeleteAlertInput{Corp: "corp", Site: "www", ID: a.ID}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Alerts_validation(t *testing.T) {
+	c := &Client{}
+	var err error
+
+	_, err = c.ListAlerts(&ListAlertsInput{Corp: "corp"})
+	if err != ErrMissingSite {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.CreateAlert(&CreateAlertInput{Corp: "corp", Site: "www"})
+	if err != ErrMissingTagName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.GetAlert(&GetAlertInput{Corp: "corp", Site: "www"})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = c.DeleteAlert(&DeleteAlertInput{})
+	if err != ErrMissingCorp {
+		t.Errorf("bad error: %s", err)
+	}
+}
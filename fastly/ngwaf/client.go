@@ -0,0 +1,158 @@
+// Package ngwaf is a client for the Next-Gen WAF (formerly Signal Sciences)
+// API, which manages corps, sites, rules, lists and alerts.
+//
+// The Next-Gen WAF API is served from its own host and authenticates with an
+// account email and access token rather than a Fastly API token, but the
+// client is built on fastly.Client so that options such as WithRetryPolicy
+// and WithLogger apply to it as well.
+package ngwaf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+const (
+	// DefaultEndpoint is the default endpoint of the Next-Gen WAF API.
+	DefaultEndpoint = "https://dashboard.signalsciences.net/api/v0"
+
+	// EndpointEnvVar is the name of an environment variable that can be used
+	// to change the URL of API requests.
+	EndpointEnvVar = "SIGSCI_API_URL"
+
+	// EmailEnvVar and TokenEnvVar are the names of the environment variables
+	// read by NewClientFromEnv.
+	EmailEnvVar = "SIGSCI_EMAIL"
+	TokenEnvVar = "SIGSCI_TOKEN"
+
+	// EmailHeader and TokenHeader are the headers used to authenticate
+	// requests.
+	EmailHeader = "X-Api-User"
+	TokenHeader = "X-Api-Token"
+)
+
+// ErrMissingEmail is an error that is returned when a client is created
+// without an account email.
+var ErrMissingEmail = fastly.NewFieldError("Email")
+
+// ErrMissingToken is an error that is returned when a client is created
+// without an access token.
+var ErrMissingToken = fastly.NewFieldError("Token")
+
+// ErrMissingCorp is an error that is returned when an input struct requires
+// a "Corp" key, but one was not set.
+var ErrMissingCorp = fastly.NewFieldError("Corp")
+
+// ErrMissingSite is an error that is returned when an input struct requires
+// a "Site" key, but one was not set.
+var ErrMissingSite = fastly.NewFieldError("Site")
+
+// ErrMissingID is an error that is returned when an input struct requires an
+// "ID" key, but one was not set.
+var ErrMissingID = fastly.NewFieldError("ID")
+
+// ErrMissingName is an error that is returned when an input struct requires
+// a "Name" key, but one was not set.
+var ErrMissingName = fastly.NewFieldError("Name")
+
+// ErrMissingRule is an error that is returned when an input struct requires
+// a "Rule" key, but one was not set.
+var ErrMissingRule = fastly.NewFieldError("Rule")
+
+// ErrMissingTagName is an error that is returned when an input struct
+// requires a "TagName" key, but one was not set.
+var ErrMissingTagName = fastly.NewFieldError("TagName")
+
+// ErrMissingType is an error that is returned when an input struct requires
+// a "Type" key, but one was not set.
+var ErrMissingType = fastly.NewFieldError("Type")
+
+// Client is a Next-Gen WAF API client.
+type Client struct {
+	client *fastly.Client
+	email  string
+	token  string
+}
+
+// NewClient creates a client for the Next-Gen WAF API, using the endpoint in
+// EndpointEnvVar, if set, else DefaultEndpoint.
+func NewClient(email, token string, opts ...fastly.ClientOption) (*Client, error) {
+	endpoint, ok := os.LookupEnv(EndpointEnvVar)
+	if !ok {
+		endpoint = DefaultEndpoint
+	}
+	return NewClientForEndpoint(email, token, endpoint, opts...)
+}
+
+// NewClientFromEnv creates a client with the credentials in EmailEnvVar and
+// TokenEnvVar.
+func NewClientFromEnv(opts ...fastly.ClientOption) (*Client, error) {
+	return NewClient(os.Getenv(EmailEnvVar), os.Getenv(TokenEnvVar), opts...)
+}
+
+// NewClientForEndpoint creates a client for the Next-Gen WAF API at the given
+// endpoint.
+func NewClientForEndpoint(email, token, endpoint string, opts ...fastly.ClientOption) (*Client, error) {
+	if email == "" {
+		return nil, ErrMissingEmail
+	}
+	if token == "" {
+		return nil, ErrMissingToken
+	}
+
+	c, err := fastly.NewClientForEndpoint("", endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{client: c, email: email, token: token}, nil
+}
+
+// request sends an authenticated JSON request. If in is not nil it is sent as
+// the body, and if out is not nil the response body is decoded into it.
+func (c *Client) request(verb, path string, in, out interface{}) error {
+	ro := &fastly.RequestOptions{
+		Headers: map[string]string{
+			EmailHeader: c.email,
+			TokenHeader: c.token,
+			"Accept":    "application/json",
+		},
+		Parallel: true,
+	}
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		ro.Body = bytes.NewReader(body)
+		ro.BodyLength = int64(len(body))
+		ro.Headers["Content-Type"] = "application/json"
+	}
+
+	resp, err := c.client.Request(verb, path, ro)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// scopePath returns the path of a resource collection that exists at the corp
+// level and, when site is not empty, at the site level.
+func scopePath(corp, site, collection string) string {
+	if site == "" {
+		return fmt.Sprintf("/corps/%s/%s", url.PathEscape(corp), collection)
+	}
+	return fmt.Sprintf("/corps/%s/sites/%s/%s", url.PathEscape(corp), url.PathEscape(site), collection)
+}
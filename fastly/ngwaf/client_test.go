@@ -0,0 +1,63 @@
+package ngwaf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// newTestClient returns a client for a test server that checks the
+// authentication headers before passing requests to h.
+func newTestClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(EmailHeader) != "user@example.com" || r.Header.Get(TokenHeader) != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(fastly.APIKeyHeader) != "" {
+			t.Errorf("unexpected %s header", fastly.APIKeyHeader)
+		}
+		h(w, r)
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := NewClientForEndpoint("user@example.com", "token", ts.URL+"/api/v0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNewClient_validation(t *testing.T) {
+	var err error
+	_, err = NewClient("", "token")
+	if err != ErrMissingEmail {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = NewClient("user@example.com", "")
+	if err != ErrMissingToken {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_error(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Site not found"}`))
+	})
+
+	_, err := c.GetSite(&GetSiteInput{Corp: "corp", Site: "missing"})
+	herr, ok := err.(*fastly.HTTPError)
+	if !ok || !herr.IsNotFound() {
+		t.Fatalf("bad error: %v", err)
+	}
+	if len(herr.Errors) != 1 || herr.Errors[0].Title != "Site not found" {
+		t.Errorf("bad error message: %v", herr)
+	}
+}
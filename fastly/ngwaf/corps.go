@@ -0,0 +1,46 @@
+package ngwaf
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Corp is a Next-Gen WAF corp, the top level account that sites belong to.
+type Corp struct {
+	Name                   string    `json:"name"`
+	DisplayName            string    `json:"displayName"`
+	Created                time.Time `json:"created"`
+	SessionMaxAgeDashboard int       `json:"sessionMaxAgeDashboard"`
+}
+
+// ListCorps returns the corps the credentials have access to.
+func (c *Client) ListCorps() ([]*Corp, error) {
+	var out struct {
+		Data []*Corp `json:"data"`
+	}
+	if err := c.request("GET", "/corps", nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetCorpInput is used as input to the GetCorp function.
+type GetCorpInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+}
+
+// GetCorp returns a corp.
+func (c *Client) GetCorp(i *GetCorpInput) (*Corp, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+
+	var corp *Corp
+	path := fmt.Sprintf("/corps/%s", url.PathEscape(i.Corp))
+	if err := c.request("GET", path, nil, &corp); err != nil {
+		return nil, err
+	}
+	return corp, nil
+}
@@ -0,0 +1,76 @@
+package ngwaf
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CorpsAndSites(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v0/corps":
+			fmt.Fprint(w, `{"data":[{"name":"corp","displayName":"Corp","created":"2022-06-01T10:00:00Z"}]}`)
+		case "/api/v0/corps/corp":
+			fmt.Fprint(w, `{"name":"corp","displayName":"Corp","sessionMaxAgeDashboard":86400}`)
+		case "/api/v0/corps/corp/sites":
+			fmt.Fprint(w, `{"data":[{"name":"www","displayName":"WWW","agentLevel":"block"}],"totalCount":1}`)
+		case "/api/v0/corps/corp/sites/www":
+			fmt.Fprint(w, `{"name":"www","displayName":"WWW","agentLevel":"block","blockHTTPCode":406,"blockDurationSeconds":86400}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	corps, err := c.ListCorps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(corps) != 1 || corps[0].Name != "corp" || corps[0].Created.IsZero() {
+		t.Errorf("bad corps: %+v", corps)
+	}
+
+	corp, err := c.GetCorp(&GetCorpInput{Corp: "corp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if corp.SessionMaxAgeDashboard != 86400 {
+		t.Errorf("bad corp: %+v", corp)
+	}
+
+	sites, err := c.ListSites(&ListSitesInput{Corp: "corp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != 1 || sites[0].AgentLevel != "block" {
+		t.Errorf("bad sites: %+v", sites)
+	}
+
+	site, err := c.GetSite(&GetSiteInput{Corp: "corp", Site: "www"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.BlockHTTPCode != 406 {
+		t.Errorf("bad site: %+v", site)
+	}
+}
+
+func TestClient_CorpsAndSites_validation(t *testing.T) {
+	c := &Client{}
+	var err error
+
+	_, err = c.GetCorp(&GetCorpInput{})
+	if err != ErrMissingCorp {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.ListSites(&ListSitesInput{})
+	if err != ErrMissingCorp {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.GetSite(&GetSiteInput{Corp: "corp"})
+	if err != ErrMissingSite {
+		t.Errorf("bad error: %s", err)
+	}
+}
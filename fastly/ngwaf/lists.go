@@ -0,0 +1,160 @@
+package ngwaf
+
+import (
+	"time"
+)
+
+// List is a named list of values, such as IP addresses or countries, that
+// rules can match against.
+type List struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	Entries     []string   `json:"entries"`
+	CreatedBy   string     `json:"createdBy"`
+	Created     *time.Time `json:"created"`
+	Updated     *time.Time `json:"updated"`
+}
+
+// ListListsInput is used as input to the ListLists function.
+type ListListsInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for corp level lists.
+	Site string
+}
+
+// ListLists returns the lists of a corp or site.
+func (c *Client) ListLists(i *ListListsInput) ([]*List, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+
+	var out struct {
+		Data []*List `json:"data"`
+	}
+	if err := c.request("GET", scopePath(i.Corp, i.Site, "lists"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetListInput is used as input to the GetList function.
+type GetListInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for corp level lists.
+	Site string
+	// ID is the ID of the list (required).
+	ID string
+}
+
+// GetList returns a list.
+func (c *Client) GetList(i *GetListInput) (*List, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	var l *List
+	if err := c.request("GET", scopePath(i.Corp, i.Site, "lists/"+i.ID), nil, &l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// CreateListInput is used as input to the CreateList function.
+type CreateListInput struct {
+	// Corp is the name of the corp (required).
+	Corp string `json:"-"`
+	// Site is the name of the site, or empty for a corp level list.
+	Site string `json:"-"`
+
+	// Name is the name of the list (required).
+	Name string `json:"name"`
+	// Type is the type of the entries, e.g. "ip", "country", "string" or
+	// "wildcard" (required).
+	Type        string   `json:"type"`
+	Description string   `json:"description,omitempty"`
+	Entries     []string `json:"entries"`
+}
+
+// CreateList creates a list.
+func (c *Client) CreateList(i *CreateListInput) (*List, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+	if i.Type == "" {
+		return nil, ErrMissingType
+	}
+
+	var l *List
+	if err := c.request("POST", scopePath(i.Corp, i.Site, "lists"), i, &l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ListEntriesChanges are the entries to add to and remove from a list.
+type ListEntriesChanges struct {
+	Additions []string `json:"additions,omitempty"`
+	Deletions []string `json:"deletions,omitempty"`
+}
+
+// UpdateListInput is used as input to the UpdateList function.
+type UpdateListInput struct {
+	// Corp is the name of the corp (required).
+	Corp string `json:"-"`
+	// Site is the name of the site, or empty for a corp level list.
+	Site string `json:"-"`
+	// ID is the ID of the list (required).
+	ID string `json:"-"`
+
+	Description *string             `json:"description,omitempty"`
+	Entries     *ListEntriesChanges `json:"entries,omitempty"`
+}
+
+// UpdateList updates the description of a list and adds or removes entries
+// without sending the whole list.
+func (c *Client) UpdateList(i *UpdateListInput) (*List, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	var l *List
+	if err := c.request("PATCH", scopePath(i.Corp, i.Site, "lists/"+i.ID), i, &l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// DeleteListInput is used as input to the DeleteList function.
+type DeleteListInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for a corp level list.
+	Site string
+	// ID is the ID of the list (required).
+	ID string
+}
+
+// DeleteList deletes a list.
+func (c *Client) DeleteList(i *DeleteListInput) error {
+	if i.Corp == "" {
+		return ErrMissingCorp
+	}
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	return c.request("DELETE", scopePath(i.Corp, i.Site, "lists/"+i.ID), nil, nil)
+}
@@ -0,0 +1,101 @@
+package ngwaf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Lists(t *testing.T) {
+	var update map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v0/corps/corp/lists":
+			var l *List
+			json.NewDecoder(r.Body).Decode(&l)
+			l.ID = "corp.blocklist"
+			json.NewEncoder(w).Encode(l)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v0/corps/corp/lists/corp.blocklist":
+			json.NewDecoder(r.Body).Decode(&update)
+			fmt.Fprint(w, `{"id":"corp.blocklist","name":"blocklist","type":"ip","entries":["192.0.2.1","198.51.100.0/24"]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v0/corps/corp/sites/www/lists":
+			fmt.Fprint(w, `{"data":[{"id":"site.allowlist","name":"allowlist","type":"ip","entries":["203.0.113.5"]}]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v0/corps/corp/lists/corp.blocklist":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	l, err := c.CreateList(&CreateListInput{
+		Corp:    "corp",
+		Name:    "blocklist",
+		Type:    "ip",
+		Entries: []string{"192.0.2.1", "192.0.2.2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.ID != "corp.blocklist" || len(l.Entries) != 2 {
+		t.Errorf("bad list: %+v", l)
+	}
+
+	l, err = c.UpdateList(&UpdateListInput{
+		Corp: "corp",
+		ID:   l.ID,
+		Entries: &ListEntriesChanges{
+			Additions: []string{"198.51.100.0/24"},
+			Deletions: []string{"192.0.2.2"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(update["entries"]) != "map[additions:[198.51.100.0/24] deletions:[192.0.2.2]]" {
+		t.Errorf("bad update body: %v", update)
+	}
+	if _, ok := update["description"]; ok {
+		t.Errorf("unexpected description in update body: %v", update)
+	}
+	if len(l.Entries) != 2 {
+		t.Errorf("bad list: %+v", l)
+	}
+
+	ls, err := c.ListLists(&ListListsInput{Corp: "corp", Site: "www"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 1 || ls[0].Name != "allowlist" {
+		t.Errorf("bad lists: %+v", ls)
+	}
+
+	if err := c.DeleteList(&DeleteListInput{Corp: "corp", ID: l.ID}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Lists_validation(t *testing.T) {
+	c := &Client{}
+	var err error
+
+	_, err = c.CreateList(&CreateListInput{Corp: "corp"})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.CreateList(&CreateListInput{Corp: "corp", Name: "blocklist"})
+	if err != ErrMissingType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.UpdateList(&UpdateListInput{Corp: "corp"})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = c.DeleteList(&DeleteListInput{})
+	if err != ErrMissingCorp {
+		t.Errorf("bad error: %s", err)
+	}
+}
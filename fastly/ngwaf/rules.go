@@ -0,0 +1,169 @@
+package ngwaf
+
+import (
+	"time"
+)
+
+// RuleCondition is a condition of a rule. Single conditions compare a field
+// with a value, while group conditions combine nested conditions.
+type RuleCondition struct {
+	Type          string           `json:"type"`
+	Field         string           `json:"field,omitempty"`
+	Operator      string           `json:"operator,omitempty"`
+	Value         string           `json:"value,omitempty"`
+	GroupOperator string           `json:"groupOperator,omitempty"`
+	Conditions    []*RuleCondition `json:"conditions,omitempty"`
+}
+
+// RuleAction is an action taken when a rule matches, such as "block",
+// "allow" or "addSignal".
+type RuleAction struct {
+	Type   string `json:"type"`
+	Signal string `json:"signal,omitempty"`
+}
+
+// Rule is a request, signal or rate limit rule of a corp or site.
+type Rule struct {
+	ID            string           `json:"id,omitempty"`
+	SiteNames     []string         `json:"siteNames,omitempty"`
+	Type          string           `json:"type"`
+	Enabled       bool             `json:"enabled"`
+	GroupOperator string           `json:"groupOperator"`
+	Conditions    []*RuleCondition `json:"conditions"`
+	Actions       []*RuleAction    `json:"actions"`
+	Signal        string           `json:"signal,omitempty"`
+	Reason        string           `json:"reason"`
+	Expiration    string           `json:"expiration"`
+	CorpScope     string           `json:"corpScope,omitempty"`
+	CreatedBy     string           `json:"createdBy,omitempty"`
+	Created       *time.Time       `json:"created,omitempty"`
+	Updated       *time.Time       `json:"updated,omitempty"`
+}
+
+// ListRulesInput is used as input to the ListRules function.
+type ListRulesInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for corp level rules.
+	Site string
+}
+
+// ListRules returns the rules of a corp or site.
+func (c *Client) ListRules(i *ListRulesInput) ([]*Rule, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+
+	var out struct {
+		Data []*Rule `json:"data"`
+	}
+	if err := c.request("GET", scopePath(i.Corp, i.Site, "rules"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetRuleInput is used as input to the GetRule function.
+type GetRuleInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for corp level rules.
+	Site string
+	// ID is the ID of the rule (required).
+	ID string
+}
+
+// GetRule returns a rule.
+func (c *Client) GetRule(i *GetRuleInput) (*Rule, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	var r *Rule
+	if err := c.request("GET", scopePath(i.Corp, i.Site, "rules/"+i.ID), nil, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// CreateRuleInput is used as input to the CreateRule function.
+type CreateRuleInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for a corp level rule.
+	Site string
+	// Rule is the rule to create (required). Its ID is ignored.
+	Rule *Rule
+}
+
+// CreateRule creates a rule.
+func (c *Client) CreateRule(i *CreateRuleInput) (*Rule, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Rule == nil {
+		return nil, ErrMissingRule
+	}
+
+	var r *Rule
+	if err := c.request("POST", scopePath(i.Corp, i.Site, "rules"), i.Rule, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UpdateRuleInput is used as input to the UpdateRule function.
+type UpdateRuleInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for a corp level rule.
+	Site string
+	// ID is the ID of the rule (required).
+	ID string
+	// Rule is the complete new definition of the rule (required).
+	Rule *Rule
+}
+
+// UpdateRule replaces the definition of a rule.
+func (c *Client) UpdateRule(i *UpdateRuleInput) (*Rule, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+	if i.Rule == nil {
+		return nil, ErrMissingRule
+	}
+
+	var r *Rule
+	if err := c.request("PUT", scopePath(i.Corp, i.Site, "rules/"+i.ID), i.Rule, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DeleteRuleInput is used as input to the DeleteRule function.
+type DeleteRuleInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site, or empty for a corp level rule.
+	Site string
+	// ID is the ID of the rule (required).
+	ID string
+}
+
+// DeleteRule deletes a rule.
+func (c *Client) DeleteRule(i *DeleteRuleInput) error {
+	if i.Corp == "" {
+		return ErrMissingCorp
+	}
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	return c.request("DELETE", scopePath(i.Corp, i.Site, "rules/"+i.ID), nil, nil)
+}
@@ -0,0 +1,106 @@
+package ngwaf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Rules(t *testing.T) {
+	var created *Rule
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v0/corps/corp/sites/www/rules":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			created.ID = "rule-id"
+			json.NewEncoder(w).Encode(created)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v0/corps/corp/rules":
+			fmt.Fprint(w, `{"data":[{"id":"corp-rule","type":"request","siteNames":["www"]}]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v0/corps/corp/sites/www/rules/rule-id":
+			var u *Rule
+			json.NewDecoder(r.Body).Decode(&u)
+			u.ID = "rule-id"
+			json.NewEncoder(w).Encode(u)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v0/corps/corp/sites/www/rules/rule-id":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r, err := c.CreateRule(&CreateRuleInput{
+		Corp: "corp",
+		Site: "www",
+		Rule: &Rule{
+			Type:          "request",
+			Enabled:       true,
+			GroupOperator: "all",
+			Conditions: []*RuleCondition{
+				{Type: "single", Field: "ip", Operator: "inList", Value: "corp.blocklist"},
+			},
+			Actions: []*RuleAction{{Type: "block"}},
+			Reason:  "blocklist",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.ID != "rule-id" || len(r.Conditions) != 1 || r.Conditions[0].Value != "corp.blocklist" {
+		t.Errorf("bad rule: %+v", r)
+	}
+
+	rs, err := c.ListRules(&ListRulesInput{Corp: "corp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 1 || rs[0].ID != "corp-rule" {
+		t.Errorf("bad rules: %+v", rs)
+	}
+
+	r.Enabled = false
+	r, err = c.UpdateRule(&UpdateRuleInput{Corp: "corp", Site: "www", ID: r.ID, Rule: r})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Enabled {
+		t.Errorf("bad rule: %+v", r)
+	}
+
+	if err := c.DeleteRule(&DeleteRuleInput{Corp: "corp", Site: "www", ID: r.ID}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Rules_validation(t *testing.T) {
+	c := &Client{}
+	var err error
+
+	_, err = c.ListRules(&ListRulesInput{})
+	if err != ErrMissingCorp {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.GetRule(&GetRuleInput{Corp: "corp"})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.CreateRule(&CreateRuleInput{Corp: "corp"})
+	if err != ErrMissingRule {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.UpdateRule(&UpdateRuleInput{Corp: "corp", ID: "rule-id"})
+	if err != ErrMissingRule {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = c.DeleteRule(&DeleteRuleInput{Corp: "corp"})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,63 @@
+package ngwaf
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Site is a Next-Gen WAF site (also known as a workspace) within a corp.
+type Site struct {
+	Name                 string    `json:"name"`
+	DisplayName          string    `json:"displayName"`
+	AgentLevel           string    `json:"agentLevel"`
+	BlockHTTPCode        int       `json:"blockHTTPCode"`
+	BlockDurationSeconds int       `json:"blockDurationSeconds"`
+	Created              time.Time `json:"created"`
+}
+
+// ListSitesInput is used as input to the ListSites function.
+type ListSitesInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+}
+
+// ListSites returns the sites of a corp.
+func (c *Client) ListSites(i *ListSitesInput) ([]*Site, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+
+	var out struct {
+		Data []*Site `json:"data"`
+	}
+	if err := c.request("GET", scopePath(i.Corp, "", "sites"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}
+
+// GetSiteInput is used as input to the GetSite function.
+type GetSiteInput struct {
+	// Corp is the name of the corp (required).
+	Corp string
+	// Site is the name of the site (required).
+	Site string
+}
+
+// GetSite returns a site.
+func (c *Client) GetSite(i *GetSiteInput) (*Site, error) {
+	if i.Corp == "" {
+		return nil, ErrMissingCorp
+	}
+	if i.Site == "" {
+		return nil, ErrMissingSite
+	}
+
+	var site *Site
+	path := fmt.Sprintf("/corps/%s/sites/%s", url.PathEscape(i.Corp), url.PathEscape(i.Site))
+	if err := c.request("GET", path, nil, &site); err != nil {
+		return nil, err
+	}
+	return site, nil
+}
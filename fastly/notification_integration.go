@@ -0,0 +1,211 @@
+package fastly
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// IntegrationTypeMailingList sends notifications to an email address.
+	IntegrationTypeMailingList IntegrationType = "mailinglist"
+
+	// IntegrationTypeMicrosoftTeams sends notifications to a Microsoft Teams
+	// incoming webhook.
+	IntegrationTypeMicrosoftTeams IntegrationType = "microsoftteams"
+
+	// IntegrationTypeNewRelic sends notifications to New Relic.
+	IntegrationTypeNewRelic IntegrationType = "newrelic"
+
+	// IntegrationTypePagerDuty sends notifications to a PagerDuty service.
+	IntegrationTypePagerDuty IntegrationType = "pagerduty"
+
+	// IntegrationTypeSlack sends notifications to a Slack incoming webhook.
+	IntegrationTypeSlack IntegrationType = "slack"
+
+	// IntegrationTypeWebhook sends notifications to an arbitrary HTTP
+	// endpoint.
+	IntegrationTypeWebhook IntegrationType = "webhook"
+)
+
+// IntegrationType is the destination kind of a notification integration.
+type IntegrationType string
+
+// NotificationIntegration is a destination that observability alerts are
+// delivered to.
+type NotificationIntegration struct {
+	ID          string            `mapstructure:"id"`
+	Name        string            `mapstructure:"name"`
+	Description string            `mapstructure:"description"`
+	Type        IntegrationType   `mapstructure:"type"`
+	Status      string            `mapstructure:"status"`
+	Config      map[string]string `mapstructure:"config"`
+	CreatedAt   *time.Time        `mapstructure:"created_at"`
+	UpdatedAt   *time.Time        `mapstructure:"updated_at"`
+}
+
+// NotificationIntegrationsMeta holds the cursor pagination details of a list
+// of notification integrations.
+type NotificationIntegrationsMeta struct {
+	NextCursor string `mapstructure:"next_cursor"`
+	Limit      int    `mapstructure:"limit"`
+	Total      int    `mapstructure:"total"`
+}
+
+// NotificationIntegrationsResponse is a page of notification integrations.
+type NotificationIntegrationsResponse struct {
+	Data []*NotificationIntegration   `mapstructure:"data"`
+	Meta NotificationIntegrationsMeta `mapstructure:"meta"`
+}
+
+// ListNotificationIntegrationsInput is used as input to the
+// ListNotificationIntegrations function.
+type ListNotificationIntegrationsInput struct {
+	// Cursor is the value of NextCursor from a previous page (optional).
+	Cursor string
+	// Limit is the maximum number of integrations to return (optional).
+	Limit int
+	// Type limits the returned integrations to a specific type (optional).
+	Type IntegrationType
+}
+
+// ListNotificationIntegrations returns a page of notification integrations.
+func (c *Client) ListNotificationIntegrations(i *ListNotificationIntegrationsInput) (*NotificationIntegrationsResponse, error) {
+	ro := &RequestOptions{
+		Params: map[string]string{},
+	}
+	if i.Cursor != "" {
+		ro.Params["cursor"] = i.Cursor
+	}
+	if i.Limit != 0 {
+		ro.Params["limit"] = strconv.Itoa(i.Limit)
+	}
+	if i.Type != "" {
+		ro.Params["type"] = string(i.Type)
+	}
+
+	resp, err := c.Get("/notifications/integrations", ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var nir *NotificationIntegrationsResponse
+	if err := decodeBodyMap(resp.Body, &nir); err != nil {
+		return nil, err
+	}
+	return nir, nil
+}
+
+// GetNotificationIntegrationInput is used as input to the
+// GetNotificationIntegration function.
+type GetNotificationIntegrationInput struct {
+	// IntegrationID is the ID of the integration to fetch (required).
+	IntegrationID string
+}
+
+// GetNotificationIntegration retrieves a notification integration using its
+// ID.
+func (c *Client) GetNotificationIntegration(i *GetNotificationIntegrationInput) (*NotificationIntegration, error) {
+	if i.IntegrationID == "" {
+		return nil, ErrMissingIntegrationID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s", i.IntegrationID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ni *NotificationIntegration
+	if err := decodeBodyMap(resp.Body, &ni); err != nil {
+		return nil, err
+	}
+	return ni, nil
+}
+
+// CreateNotificationIntegrationInput is used as input to the
+// CreateNotificationIntegration function.
+type CreateNotificationIntegrationInput struct {
+	// Name is the name of the integration (required).
+	Name string `json:"name"`
+	// Description is a free-form description of the integration (optional).
+	Description string `json:"description,omitempty"`
+	// Type is the kind of destination (required).
+	Type IntegrationType `json:"type"`
+	// Config holds the type-specific settings (required), e.g. "webhook" for
+	// Slack, Microsoft Teams and webhook integrations, "key" for PagerDuty or
+	// "address" for mailing lists.
+	Config map[string]string `json:"config"`
+}
+
+// CreateNotificationIntegration creates a new notification integration.
+func (c *Client) CreateNotificationIntegration(i *CreateNotificationIntegrationInput) (*NotificationIntegration, error) {
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+	if i.Type == "" {
+		return nil, ErrMissingType
+	}
+	if len(i.Config) == 0 {
+		return nil, ErrMissingConfig
+	}
+
+	resp, err := c.PostJSON("/notifications/integrations", i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ni *NotificationIntegration
+	if err := decodeBodyMap(resp.Body, &ni); err != nil {
+		return nil, err
+	}
+	return ni, nil
+}
+
+// UpdateNotificationIntegrationInput is used as input to the
+// UpdateNotificationIntegration function.
+type UpdateNotificationIntegrationInput struct {
+	// IntegrationID is the ID of the integration to update (required).
+	IntegrationID string `json:"-"`
+
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Config      map[string]string `json:"config,omitempty"`
+}
+
+// UpdateNotificationIntegration updates a notification integration.
+func (c *Client) UpdateNotificationIntegration(i *UpdateNotificationIntegrationInput) (*NotificationIntegration, error) {
+	if i.IntegrationID == "" {
+		return nil, ErrMissingIntegrationID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s", i.IntegrationID)
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ni *NotificationIntegration
+	if err := decodeBodyMap(resp.Body, &ni); err != nil {
+		return nil, err
+	}
+	return ni, nil
+}
+
+// DeleteNotificationIntegrationInput is used as input to the
+// DeleteNotificationIntegration function.
+type DeleteNotificationIntegrationInput struct {
+	// IntegrationID is the ID of the integration to delete (required).
+	IntegrationID string
+}
+
+// DeleteNotificationIntegration deletes a notification integration.
+func (c *Client) DeleteNotificationIntegration(i *DeleteNotificationIntegrationInput) error {
+	if i.IntegrationID == "" {
+		return ErrMissingIntegrationID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s", i.IntegrationID)
+	_, err := c.Delete(path, nil)
+	return err
+}
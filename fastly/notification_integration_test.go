@@ -0,0 +1,149 @@
+package fastly
+
+import "testing"
+
+func TestClient_NotificationIntegrations(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "notification_integrations/"
+
+	// Create
+	var err error
+	var ni *NotificationIntegration
+	record(t, fixtureBase+"create", func(c *Client) {
+		ni, err = c.CreateNotificationIntegration(&CreateNotificationIntegrationInput{
+			Name:        "go-fastly-test",
+			Description: "alerts channel",
+			Type:        IntegrationTypeSlack,
+			Config: map[string]string{
+				"webhook": "https://hooks.slack.com/services/T000/B000/XXXX",
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, fixtureBase+"cleanup", func(c *Client) {
+			c.DeleteNotificationIntegration(&DeleteNotificationIntegrationInput{
+				IntegrationID: ni.ID,
+			})
+		})
+	}()
+
+	if ni.Name != "go-fastly-test" {
+		t.Errorf("bad name: %q", ni.Name)
+	}
+	if ni.Type != IntegrationTypeSlack {
+		t.Errorf("bad type: %q", ni.Type)
+	}
+	if ni.Config["webhook"] != "https://hooks.slack.com/services/T000/B000/XXXX" {
+		t.Errorf("bad config: %v", ni.Config)
+	}
+	if ni.CreatedAt == nil {
+		t.Errorf("bad created_at: %v", ni.CreatedAt)
+	}
+
+	// List
+	var nis *NotificationIntegrationsResponse
+	record(t, fixtureBase+"list", func(c *Client) {
+		nis, err = c.ListNotificationIntegrations(&ListNotificationIntegrationsInput{
+			Type:  IntegrationTypeSlack,
+			Limit: 10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nis.Data) != 1 || nis.Data[0].ID != ni.ID {
+		t.Errorf("bad integrations: %v", nis.Data)
+	}
+	if nis.Meta.Total != 1 {
+		t.Errorf("bad total: %d", nis.Meta.Total)
+	}
+
+	// Get
+	var gni *NotificationIntegration
+	record(t, fixtureBase+"get", func(c *Client) {
+		gni, err = c.GetNotificationIntegration(&GetNotificationIntegrationInput{
+			IntegrationID: ni.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gni.Description != "alerts channel" {
+		t.Errorf("bad description: %q", gni.Description)
+	}
+
+	// Update
+	var uni *NotificationIntegration
+	record(t, fixtureBase+"update", func(c *Client) {
+		uni, err = c.UpdateNotificationIntegration(&UpdateNotificationIntegrationInput{
+			IntegrationID: ni.ID,
+			Name:          String("new-go-fastly-test"),
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uni.Name != "new-go-fastly-test" {
+		t.Errorf("bad name: %q", uni.Name)
+	}
+
+	// Delete
+	record(t, fixtureBase+"delete", func(c *Client) {
+		err = c.DeleteNotificationIntegration(&DeleteNotificationIntegrationInput{
+			IntegrationID: ni.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_CreateNotificationIntegration_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateNotificationIntegration(&CreateNotificationIntegrationInput{})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateNotificationIntegration(&CreateNotificationIntegrationInput{
+		Name: "test",
+	})
+	if err != ErrMissingType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateNotificationIntegration(&CreateNotificationIntegrationInput{
+		Name: "test",
+		Type: IntegrationTypeWebhook,
+	})
+	if err != ErrMissingConfig {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetNotificationIntegration_validation(t *testing.T) {
+	_, err := testClient.GetNotificationIntegration(&GetNotificationIntegrationInput{})
+	if err != ErrMissingIntegrationID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateNotificationIntegration_validation(t *testing.T) {
+	_, err := testClient.UpdateNotificationIntegration(&UpdateNotificationIntegrationInput{})
+	if err != ErrMissingIntegrationID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteNotificationIntegration_validation(t *testing.T) {
+	err := testClient.DeleteNotificationIntegration(&DeleteNotificationIntegrationInput{})
+	if err != ErrMissingIntegrationID {
+		t.Errorf("bad error: %s", err)
+	}
+}
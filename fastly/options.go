@@ -0,0 +1,265 @@
+package fastly
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClientOption configures optional behaviour of a Client at construction time.
+//
+// Options are passed to NewClient (or NewClientForEndpoint) and are applied in
+// order, so later options take precedence over earlier ones.
+type ClientOption func(*Client)
+
+// Logger is the interface used by a Client to write diagnostic messages, such
+// as notices about retried requests. It is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithEndpoint sets the address of the Fastly API endpoint, overriding both
+// DefaultEndpoint and the EndpointEnvVar environment variable.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(c *Client) {
+		c.Address = endpoint
+	}
+}
+
+// WithUserAgent replaces the default User-Agent sent with every request.
+// Applications added with WithApplication are still appended to it.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to issue requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = hc
+	}
+}
+
+// WithLogger sets the Logger that receives the client's diagnostic messages.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithRetries enables retrying of failed idempotent requests up to the given
+// number of times, using the default backoff (see RetryPolicy).
+func WithRetries(maxRetries int) ClientOption {
+	return WithRetryPolicy(RetryPolicy{MaxRetries: maxRetries})
+}
+
+// WithRetryPolicy enables retrying of failed requests using the given policy.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = &p
+		c.retryBudget = nil
+		if p.Budget > 0 {
+			window := p.BudgetWindow
+			if window <= 0 {
+				window = DefaultRetryBudgetWindow
+			}
+			c.retryBudget = &retryBudget{max: p.Budget, window: window, now: time.Now}
+		}
+	}
+}
+
+// WithCircuitBreaker stops the client from issuing requests after threshold
+// consecutive failures (transport errors, 429 and 5xx responses). While the
+// circuit is open requests fail immediately with ErrCircuitOpen; once cooldown
+// has passed requests are attempted again, and the first success closes the
+// circuit.
+//
+// Copies of the client created with WithToken share the same breaker.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		c.breaker = newCircuitBreaker(threshold, cooldown)
+	}
+}
+
+// WithInstrumentation sets the Instrumentation notified about every request.
+func WithInstrumentation(i Instrumentation) ClientOption {
+	return func(c *Client) {
+		c.Instrumentation = i
+	}
+}
+
+// WithApplication appends the given application name and version to the
+// User-Agent sent with every request (e.g. "FastlyGo/6.4.0 (...) my-tool/1.2.0")
+// so that API traffic can be attributed to a specific tool.
+//
+// The version is optional and is omitted from the User-Agent when empty.
+// Providing the option multiple times appends each application in order.
+func WithApplication(name, version string) ClientOption {
+	return func(c *Client) {
+		if name == "" {
+			return
+		}
+		app := name
+		if version != "" {
+			app = fmt.Sprintf("%s/%s", name, version)
+		}
+		c.applications = append(c.applications, app)
+	}
+}
+
+// WithTransport sets the base http.Transport used by the client's HTTPClient.
+// Any other transport options (e.g. WithProxy) are applied on top of it.
+func WithTransport(t *http.Transport) ClientOption {
+	return func(c *Client) {
+		c.transport = t
+	}
+}
+
+// WithProxy sets the function used to determine the proxy for a request, e.g.
+// http.ProxyURL or http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return withTransportOption(func(t *http.Transport) {
+		t.Proxy = proxy
+	})
+}
+
+// WithConnectionPool enables HTTP keep-alives and configures the maximum number
+// of idle connections kept open in total and per host.
+func WithConnectionPool(maxIdleConns, maxIdleConnsPerHost int) ClientOption {
+	return withTransportOption(func(t *http.Transport) {
+		t.DisableKeepAlives = false
+		t.MaxIdleConns = maxIdleConns
+		t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	})
+}
+
+// WithTLSConfig sets the TLS configuration used for connections to the API,
+// e.g. to trust a corporate root CA.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return withTransportOption(func(t *http.Transport) {
+		t.TLSClientConfig = config
+	})
+}
+
+// WithHTTP2 controls whether the transport attempts to negotiate HTTP/2.
+func WithHTTP2(enabled bool) ClientOption {
+	return withTransportOption(func(t *http.Transport) {
+		t.ForceAttemptHTTP2 = enabled
+		if !enabled {
+			t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+	})
+}
+
+// withTransportOption records a modification to be applied to the client's
+// http.Transport once it has been constructed.
+func withTransportOption(fn func(*http.Transport)) ClientOption {
+	return func(c *Client) {
+		c.transportOptions = append(c.transportOptions, fn)
+	}
+}
+
+// WithRequestIDGenerator sets a function that returns a new ID for every
+// modifying (non-GET/HEAD) request that does not specify its own
+// RequestOptions.RequestID. The ID is sent as the RequestIDHeader.
+func WithRequestIDGenerator(fn func() string) ClientOption {
+	return func(c *Client) {
+		c.requestIDGenerator = fn
+	}
+}
+
+// WithRateLimit limits the client to issuing at most requestsPerSecond
+// requests per second on average, allowing bursts of up to burst requests.
+// Requests (including retries) wait until they are allowed to proceed, which
+// smooths out bulk operations such as syncing thousands of dictionary items
+// so that they stay within Fastly's API rate limits.
+//
+// A requestsPerSecond of zero or less disables the limit. Copies of the client
+// created with WithToken share the same limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithCache caches the responses of endpoints returning rarely changing data,
+// such as AllIPs, AllDatacenters and the WAF rules catalog of ListAllWAFRules,
+// for the given ttl. Controllers that call these endpoints in a loop then only
+// re-fetch them once the ttl has expired.
+//
+// Copies of the client created with WithToken share the same cache.
+func WithCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = newResponseCache(ttl)
+	}
+}
+
+// WithTokenRefresher sets a TokenRefresher that is called when the API
+// rejects the client's token with a 401 Unauthorized response, so that
+// short-lived automation tokens can be renewed from a secrets manager. The
+// client then uses the new token for all its requests, and the rejected
+// request is sent again once with it if its body can be rewound. Requests
+// authenticated with RequestOptions.Token are not affected.
+func WithTokenRefresher(fn TokenRefresher) ClientOption {
+	return func(c *Client) {
+		c.tokenRefresher = fn
+	}
+}
+
+// WithRequestCompression compresses request bodies of at least minSize bytes
+// with gzip and sends them with a "Content-Encoding: gzip" header, which cuts
+// the upload time of large payloads, such as custom VCL, batch dictionary and
+// ACL updates, and KV Store bulk inserts, on slow links. Bodies of unknown
+// size, such as the stream of BatchModifyKVStoreKeys, are always compressed,
+// while they are sent. Requests that set their own Content-Encoding header are
+// sent as is.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) {
+		if minSize <= 0 {
+			minSize = 1
+		}
+		c.compressMinSize = minSize
+	}
+}
+
+// WithStatsEndpoint sets the address that historical stats and usage requests,
+// such as GetStats and GetUsage, are sent to instead of the API endpoint. It
+// overrides the StatsEndpointEnvVar environment variable.
+func WithStatsEndpoint(endpoint string) ClientOption {
+	return func(c *Client) {
+		c.statsEndpoint = endpoint
+	}
+}
+
+// WithRealtimeStatsEndpoint sets the address of the realtime stats API used by
+// the RTSClient returned from Client.RealtimeStats. It overrides the
+// RealtimeStatsEndpointEnvVar environment variable.
+func WithRealtimeStatsEndpoint(endpoint string) ClientOption {
+	return func(c *Client) {
+		c.realtimeStatsEndpoint = endpoint
+	}
+}
+
+// WithVCLValidator sets a VCLValidator that CreateVCL and UpdateVCL run on the
+// VCL content before uploading it. If the validator reports any errors, the
+// VCL is not uploaded and a *VCLValidationError is returned, so broken VCL is
+// caught before a version is activated.
+func WithVCLValidator(v VCLValidator) ClientOption {
+	return func(c *Client) {
+		c.vclValidator = v
+		c.vclLint = false
+	}
+}
+
+// WithVCLLint is like WithVCLValidator, but checks VCL with Fastly's linter,
+// see LintVCL. The VCL is checked against the configuration of the service it
+// is uploaded to.
+func WithVCLLint() ClientOption {
+	return func(c *Client) {
+		c.vclValidator = nil
+		c.vclLint = true
+	}
+}
@@ -0,0 +1,209 @@
+package fastly
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestClient_WithApplication(t *testing.T) {
+	t.Parallel()
+
+	var ua string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+	}))
+	defer ts.Close()
+
+	c, err := NewClientForEndpoint("", ts.URL,
+		WithApplication("my-tool", "1.2.0"),
+		WithApplication("controller", ""),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := UserAgent + " my-tool/1.2.0 controller"
+	if ua != expected {
+		t.Errorf("bad user agent: %q, expected %q", ua, expected)
+	}
+}
+
+func TestClient_TransportOptions(t *testing.T) {
+	t.Parallel()
+
+	proxy, err := url.Parse("http://proxy.example.com:3128")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig := &tls.Config{ServerName: "api.fastly.com"}
+
+	c, err := NewClientForEndpoint("", DefaultEndpoint,
+		WithProxy(http.ProxyURL(proxy)),
+		WithConnectionPool(50, 10),
+		WithTLSConfig(tlsConfig),
+		WithHTTP2(false),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("bad transport type: %T", c.HTTPClient.Transport)
+	}
+	if tr.DisableKeepAlives {
+		t.Error("expected keep-alives to be enabled")
+	}
+	if tr.MaxIdleConns != 50 || tr.MaxIdleConnsPerHost != 10 {
+		t.Errorf("bad pool size: %d/%d", tr.MaxIdleConns, tr.MaxIdleConnsPerHost)
+	}
+	if tr.TLSClientConfig != tlsConfig {
+		t.Error("bad TLS config")
+	}
+	if tr.ForceAttemptHTTP2 {
+		t.Error("expected HTTP/2 to be disabled")
+	}
+
+	req, err := http.NewRequest("GET", DefaultEndpoint, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := tr.Proxy(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.String() != proxy.String() {
+		t.Errorf("bad proxy: %s", u)
+	}
+
+	base := &http.Transport{MaxIdleConns: 7}
+	c, err = NewClientForEndpoint("", DefaultEndpoint, WithTransport(base))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.HTTPClient.Transport != base {
+		t.Error("expected the provided transport to be used")
+	}
+}
+
+func TestClient_Options(t *testing.T) {
+	t.Parallel()
+
+	var ua string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+	}))
+	defer ts.Close()
+
+	hc := &http.Client{}
+	c, err := NewClient("",
+		WithEndpoint(ts.URL),
+		WithHTTPClient(hc),
+		WithUserAgent("custom-agent"),
+		WithApplication("my-tool", "1.0.0"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Address != ts.URL {
+		t.Errorf("bad address: %s", c.Address)
+	}
+	if c.HTTPClient != hc {
+		t.Error("expected the provided HTTP client to be used")
+	}
+
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if ua != "custom-agent my-tool/1.0.0" {
+		t.Errorf("bad user agent: %q", ua)
+	}
+}
+
+func TestClient_WithStatsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer api.Close()
+
+	var paths []string
+	stats := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/stats/regions":
+			w.Write([]byte(`{"status":"success","data":["usa","europe"]}`))
+		default:
+			w.Write([]byte(`{"status":"success","data":[]}`))
+		}
+	}))
+	defer stats.Close()
+
+	c, err := NewClient("", WithEndpoint(api.URL), WithStatsEndpoint(stats.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr, err := c.GetRegions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.Data) != 2 {
+		t.Errorf("bad regions: %v", rr.Data)
+	}
+	if _, err := c.GetStats(&GetStatsInput{Service: "service-id"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"/stats/regions", "/stats/service/service-id"}
+	if len(paths) != len(expected) || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+
+	// Other requests still go to the API endpoint.
+	if _, err := c.Get("/service", nil); err == nil {
+		t.Error("expected error from the API endpoint")
+	}
+	if len(paths) != 2 {
+		t.Errorf("unexpected stats request: %v", paths)
+	}
+}
+
+func TestClient_RealtimeStats(t *testing.T) {
+	t.Parallel()
+
+	var key string
+	rts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get(APIKeyHeader)
+		w.Write([]byte(`{"Timestamp":1}`))
+	}))
+	defer rts.Close()
+
+	c, err := NewClient("token", WithEndpoint("https://api.example.com"), WithRealtimeStatsEndpoint(rts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := c.RealtimeStats().GetRealtimeStats(&GetRealtimeStatsInput{ServiceID: "service-id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Timestamp != 1 {
+		t.Errorf("bad timestamp: %d", s.Timestamp)
+	}
+	if key != "token" {
+		t.Errorf("bad API key: %q", key)
+	}
+	if c.Address != "https://api.example.com" {
+		t.Errorf("client was modified: %s", c.Address)
+	}
+}
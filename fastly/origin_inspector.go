@@ -68,8 +68,8 @@ type OriginMetrics struct {
 
 // OriginMeta is the meta section returned for /metrics/origins responses
 type OriginMeta struct {
-	Start      string            `mapstructure:"start"`
-	End        string            `mapstructure:"end"`
+	Start      *time.Time        `mapstructure:"start"`
+	End        *time.Time        `mapstructure:"end"`
 	Downsample string            `mapstructure:"downsample"`
 	Metric     string            `mapstructure:"metric"`
 	Limit      int               `mapstructure:"limit"`
@@ -14,8 +14,9 @@ func TestClient_GetOriginMetricsForService(t *testing.T) {
 	end := time.Date(2022, 2, 14, 0, 0, 0, 0, time.UTC)
 	start := end.Add(-2 * 24 * time.Hour)
 	var err error
+	var resp *OriginInspector
 	record(t, "origin_inspector/metrics_for_service", func(c *Client) {
-		_, err = c.GetOriginMetricsForService(&GetOriginMetricsInput{
+		resp, err = c.GetOriginMetricsForService(&GetOriginMetricsInput{
 			ServiceID:   testServiceID,
 			Start:       start,
 			End:         end,
@@ -31,4 +32,10 @@ func TestClient_GetOriginMetricsForService(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if resp.Meta.Start == nil || !resp.Meta.Start.Equal(start) {
+		t.Errorf("bad start: %v", resp.Meta.Start)
+	}
+	if resp.Meta.End == nil || !resp.Meta.End.Equal(end) {
+		t.Errorf("bad end: %v", resp.Meta.End)
+	}
 }
@@ -1,11 +1,24 @@
 package fastly
 
 import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// MaxPackageSize is the largest package, in bytes, accepted by UpdatePackage.
+const MaxPackageSize = 100 * 1024 * 1024
+
 // Package is a container for data returned about a package.
 type Package struct {
 	ID             string
@@ -100,3 +113,191 @@ func PopulatePackage(body io.ReadCloser) (*Package, error) {
 	}
 	return p, nil
 }
+
+// InspectPackage validates the package tarball at the given local path before
+// it is uploaded. It checks that the package is within MaxPackageSize and
+// contains a fastly.toml manifest and a bin/main.wasm module, and returns the
+// metadata from the manifest along with the package's size and hashsum as
+// computed by the API. Errors about the package's content wrap
+// ErrInvalidPackage.
+func InspectPackage(packagePath string) (*PackageMetadata, error) {
+	f, err := os.Open(packagePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > MaxPackageSize {
+		return nil, fmt.Errorf("%w: size %d exceeds the maximum of %d bytes", ErrInvalidPackage, fi.Size(), MaxPackageSize)
+	}
+
+	// The hashsum is computed over the compressed package while it is read.
+	h := sha512.New()
+	gz, err := gzip.NewReader(io.TeeReader(f, h))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPackage, err)
+	}
+
+	var m *PackageMetadata
+	var hasWasm bool
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPackage, err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// Files are expected in a top-level directory named after the
+		// package, e.g. "my-package/fastly.toml".
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		dir, file := path.Split(name)
+		if strings.HasPrefix(file, "._") {
+			// AppleDouble files created by macOS tar.
+			continue
+		}
+		switch {
+		case file == "fastly.toml" && strings.Count(dir, "/") == 1:
+			if m, err = parsePackageManifest(tr); err != nil {
+				return nil, err
+			}
+		case file == "main.wasm" && strings.HasSuffix(dir, "/bin/") && strings.Count(dir, "/") == 2:
+			hasWasm = true
+		}
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("%w: missing fastly.toml manifest", ErrInvalidPackage)
+	}
+	if !hasWasm {
+		return nil, fmt.Errorf("%w: missing bin/main.wasm", ErrInvalidPackage)
+	}
+	if m.Name == "" {
+		return nil, fmt.Errorf("%w: manifest has no name", ErrInvalidPackage)
+	}
+
+	// Hash any trailing data not consumed by the readers.
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	m.Size = fi.Size()
+	m.HashSum = hex.EncodeToString(h.Sum(nil))
+	return m, nil
+}
+
+// parsePackageManifest reads the top-level name, description, authors and
+// language keys of a fastly.toml manifest.
+func parsePackageManifest(r io.Reader) (*PackageMetadata, error) {
+	m := &PackageMetadata{}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "[") {
+			// Only top-level keys describe the package.
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		var err error
+		switch key {
+		case "name":
+			m.Name, err = strconv.Unquote(value)
+		case "description":
+			m.Description, err = strconv.Unquote(value)
+		case "language":
+			m.Language, err = strconv.Unquote(value)
+		case "authors":
+			m.Authors, err = parseManifestStrings(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid %s in fastly.toml", ErrInvalidPackage, key)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// parseManifestStrings parses a single-line array of strings such as
+// ["a", "b"].
+func parseManifestStrings(value string) ([]string, error) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return nil, errors.New("not an array")
+	}
+
+	var result []string
+	for _, v := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		s, err := strconv.Unquote(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+// PackageUpToDateInput is used as input to the PackageUpToDate function.
+type PackageUpToDateInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// PackagePath is the local filesystem path to the package (required).
+	PackagePath string
+}
+
+// PackageUpToDate validates the local package with InspectPackage and reports
+// whether it has the same hashsum as the package of the given service version,
+// in which case uploading it would be a no-op. A version without a package is
+// never up to date.
+func (c *Client) PackageUpToDate(i *PackageUpToDateInput) (bool, error) {
+	if _, err := MakePackagePath(i.ServiceID, i.ServiceVersion); err != nil {
+		return false, err
+	}
+	if i.PackagePath == "" {
+		return false, ErrMissingPackagePath
+	}
+
+	local, err := InspectPackage(i.PackagePath)
+	if err != nil {
+		return false, err
+	}
+
+	remote, err := c.GetPackage(&GetPackageInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		var herr *HTTPError
+		if errors.As(err, &herr) && herr.IsNotFound() {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return remote.Metadata.HashSum == local.HashSum, nil
+}
@@ -1,6 +1,15 @@
 package fastly
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -133,3 +142,129 @@ func TestClient_UpdatePackage_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestInspectPackage(t *testing.T) {
+	t.Parallel()
+
+	m, err := InspectPackage("test_assets/package/valid.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "wasm-test" {
+		t.Errorf("bad name: %q", m.Name)
+	}
+	if m.Language != "rust" {
+		t.Errorf("bad language: %q", m.Language)
+	}
+	if len(m.Authors) != 1 || m.Authors[0] != "fastly@fastly.com" {
+		t.Errorf("bad authors: %v", m.Authors)
+	}
+	if m.Size != 2015936 {
+		t.Errorf("bad size: %d", m.Size)
+	}
+	// The hashsum matches the one reported by the API for the same package.
+	if m.HashSum != "f99485bd301e23f028474d26d398da525de17a372ae9e7026891d7f85361d2540d14b3b091929c3f170eade573595e20b3405a9e29651ede59915f2e1652f616" {
+		t.Errorf("bad hashsum: %q", m.HashSum)
+	}
+}
+
+func TestInspectPackage_invalid(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	noWasm := filepath.Join(dir, "no-wasm.tar.gz")
+	writeTestPackage(t, noWasm, map[string]string{
+		"pkg/fastly.toml": "name = \"pkg\"\nlanguage = \"go\"\n",
+	})
+	noName := filepath.Join(dir, "no-name.tar.gz")
+	writeTestPackage(t, noName, map[string]string{
+		"pkg/fastly.toml":   "language = \"go\"\n[local_server]\nname = \"ignored\"\n",
+		"pkg/bin/main.wasm": "\x00asm",
+	})
+	notGzip := filepath.Join(dir, "not-gzip.tar.gz")
+	if err := os.WriteFile(notGzip, []byte("not a package"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []string{
+		"test_assets/package/invalid.tar.gz",
+		noWasm,
+		noName,
+		notGzip,
+	} {
+		if _, err := InspectPackage(p); !errors.Is(err, ErrInvalidPackage) {
+			t.Errorf("%s: expected ErrInvalidPackage, got: %v", p, err)
+		}
+	}
+}
+
+func writeTestPackage(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_PackageUpToDate(t *testing.T) {
+	t.Parallel()
+
+	hashsum := "f99485bd301e23f028474d26d398da525de17a372ae9e7026891d7f85361d2540d14b3b091929c3f170eade573595e20b3405a9e29651ede59915f2e1652f616"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/service-id/version/1/package":
+			fmt.Fprintf(w, `{"id":"pkg","service_id":"service-id","version":1,"metadata":{"hashsum":%q}}`, hashsum)
+		case "/service/service-id/version/2/package":
+			fmt.Fprint(w, `{"id":"pkg","service_id":"service-id","version":2,"metadata":{"hashsum":"other"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for version, expected := range map[int]bool{1: true, 2: false, 3: false} {
+		ok, err := c.PackageUpToDate(&PackageUpToDateInput{
+			ServiceID:      "service-id",
+			ServiceVersion: version,
+			PackagePath:    "test_assets/package/valid.tar.gz",
+		})
+		if err != nil {
+			t.Fatalf("version %d: %s", version, err)
+		}
+		if ok != expected {
+			t.Errorf("version %d: expected %t, got %t", version, expected, ok)
+		}
+	}
+}
+
+func TestClient_PackageUpToDate_validation(t *testing.T) {
+	_, err := testClient.PackageUpToDate(&PackageUpToDateInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingPackagePath {
+		t.Errorf("bad error: %s", err)
+	}
+}
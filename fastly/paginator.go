@@ -22,3 +22,56 @@ type PaginatorServices interface {
 	Remaining() int
 	GetNext() ([]*Service, error)
 }
+
+// PaginatorKVStoreKeys represents a paginator.
+type PaginatorKVStoreKeys interface {
+	HasNext() bool
+	Remaining() int
+	GetNext() ([]string, error)
+}
+
+// PaginatorInvoices represents a paginator.
+type PaginatorInvoices interface {
+	HasNext() bool
+	Remaining() int
+	GetNext() ([]*Invoice, error)
+}
+
+// cursorPaginator is a paginator for listings that return the cursor of the
+// next page with each page, rather than page numbers.
+type cursorPaginator[T any] struct {
+	consumed bool
+	cursor   string
+	// fetch returns the items of the page at cursor and the cursor of the
+	// next page, which is empty after the last page.
+	fetch func(cursor string) ([]T, string, error)
+}
+
+// HasNext returns a boolean indicating whether more pages are available
+func (p *cursorPaginator[T]) HasNext() bool {
+	return !p.consumed || p.cursor != ""
+}
+
+// Remaining returns the remaining page count. Cursor based listings do not
+// report how many pages there are, so it is 1 while there is a next page.
+func (p *cursorPaginator[T]) Remaining() int {
+	if p.consumed && p.cursor != "" {
+		return 1
+	}
+	return 0
+}
+
+// GetNext retrieves data in the next page
+func (p *cursorPaginator[T]) GetNext() ([]T, error) {
+	if !p.HasNext() {
+		return nil, nil
+	}
+
+	items, next, err := p.fetch(p.cursor)
+	if err != nil {
+		return nil, err
+	}
+	p.consumed = true
+	p.cursor = next
+	return items, nil
+}
@@ -0,0 +1,82 @@
+//go:build go1.23
+
+package fastly
+
+import "iter"
+
+// pageIterator is implemented by the page based paginators.
+type pageIterator[T any] interface {
+	HasNext() bool
+	GetNext() ([]T, error)
+}
+
+// pages returns an iterator over the items of all pages of p, fetching each
+// page only once the items of the previous one have been consumed. A failed
+// page yields the error and ends the iteration.
+func pages[T any](p pageIterator[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasNext() {
+			items, err := p.GetNext()
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All returns an iterator over the services of all remaining pages.
+func (p *ListServicesPaginator) All() iter.Seq2[*Service, error] {
+	return pages[*Service](p)
+}
+
+// All returns an iterator over the entries of all remaining pages.
+func (p *ListAclEntriesPaginator) All() iter.Seq2[*ACLEntry, error] {
+	return pages[*ACLEntry](p)
+}
+
+// All returns an iterator over the items of all remaining pages.
+func (p *ListDictionaryItemsPaginator) All() iter.Seq2[*DictionaryItem, error] {
+	return pages[*DictionaryItem](p)
+}
+
+// All returns an iterator over the items of all remaining pages.
+func (p *cursorPaginator[T]) All() iter.Seq2[T, error] {
+	return pages[T](p)
+}
+
+// AllServices returns an iterator over all services of the account, fetching
+// pages lazily as the iteration proceeds.
+func (c *Client) AllServices(i *ListServicesInput) iter.Seq2[*Service, error] {
+	return (&ListServicesPaginator{client: c, options: i}).All()
+}
+
+// AllACLEntries returns an iterator over all entries of an ACL, fetching
+// pages lazily as the iteration proceeds.
+func (c *Client) AllACLEntries(i *ListACLEntriesInput) iter.Seq2[*ACLEntry, error] {
+	return (&ListAclEntriesPaginator{client: c, options: i}).All()
+}
+
+// AllDictionaryItems returns an iterator over all items of a dictionary,
+// fetching pages lazily as the iteration proceeds.
+func (c *Client) AllDictionaryItems(i *ListDictionaryItemsInput) iter.Seq2[*DictionaryItem, error] {
+	return (&ListDictionaryItemsPaginator{client: c, options: i}).All()
+}
+
+// AllKVStoreKeys returns an iterator over all keys of a KV Store, fetching
+// pages lazily as the iteration proceeds.
+func (c *Client) AllKVStoreKeys(i *ListKVStoreKeysInput) iter.Seq2[string, error] {
+	return pages(c.NewListKVStoreKeysPaginator(i))
+}
+
+// AllInvoices returns an iterator over all invoices of the account, fetching
+// pages lazily as the iteration proceeds.
+func (c *Client) AllInvoices(i *ListInvoicesInput) iter.Seq2[*Invoice, error] {
+	return pages(c.NewListInvoicesPaginator(i))
+}
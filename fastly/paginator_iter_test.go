@@ -0,0 +1,86 @@
+//go:build go1.23
+
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_AllInvoices(t *testing.T) {
+	t.Parallel()
+
+	var ids []string
+	var err error
+	record(t, "invoices/list", func(c *Client) {
+		for inv, ierr := range c.AllInvoices(&ListInvoicesInput{Limit: 1}) {
+			if ierr != nil {
+				err = ierr
+				break
+			}
+			ids = append(ids, inv.InvoiceID)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0] != "100" || ids[1] != "101" {
+		t.Errorf("bad invoices: %v", ids)
+	}
+}
+
+func TestClient_AllServices(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next", <`+r.URL.Path+`?page=3>; rel="last"`)
+			w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+		case "2":
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=3>; rel="next", <`+r.URL.Path+`?page=3>; rel="last"`)
+			w.Write([]byte(`[{"id":"c"}]`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pages are only fetched when needed, and a failing page ends the
+	// iteration with its error.
+	var ids []string
+	var iterErr error
+	for s, err := range c.AllServices(&ListServicesInput{PerPage: 2}) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		ids = append(ids, s.ID)
+		if s.ID == "b" && requests != 1 {
+			t.Errorf("expected 1 request before the second page, got %d", requests)
+		}
+	}
+	if len(ids) != 3 || ids[2] != "c" {
+		t.Errorf("bad services: %v", ids)
+	}
+	if iterErr == nil {
+		t.Error("expected the error of the third page")
+	}
+
+	// Breaking out of the loop stops fetching.
+	requests = 0
+	for range c.AllServices(&ListServicesInput{PerPage: 2}) {
+		break
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
@@ -2,7 +2,6 @@ package fastly
 
 import (
 	"fmt"
-	"reflect"
 	"strconv"
 	"time"
 
@@ -84,20 +83,11 @@ func (c *Client) ListBulkCertificates(i *ListBulkCertificatesInput) ([]*BulkCert
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(BulkCertificate)))
+	bc, err := decodeMany[BulkCertificate](r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	bc := make([]*BulkCertificate, len(data))
-	for i := range data {
-		typed, ok := data[i].(*BulkCertificate)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-BulkCertificate response")
-		}
-		bc[i] = typed
-	}
-
 	return bc, nil
 }
 
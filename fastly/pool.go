@@ -114,26 +114,26 @@ type CreatePoolInput struct {
 	Name string `url:"name"`
 
 	// Optional fields.
-	Comment          string      `url:"comment,omitempty"`
-	Shield           string      `url:"shield,omitempty"`
-	RequestCondition string      `url:"request_condition,omitempty"`
-	MaxConnDefault   uint        `url:"max_conn_default,omitempty"`
-	ConnectTimeout   uint        `url:"connect_timeout,omitempty"`
-	FirstByteTimeout uint        `url:"first_byte_timeout,omitempty"`
-	Quorum           uint        `url:"quorum,omitempty"`
-	UseTLS           Compatibool `url:"use_tls,omitempty"`
-	TLSCACert        string      `url:"tls_ca_cert,omitempty"`
-	TLSCiphers       string      `url:"tls_ciphers,omitempty"`
-	TLSClientKey     string      `url:"tls_client_key,omitempty"`
-	TLSClientCert    string      `url:"tls_client_cert,omitempty"`
-	TLSSNIHostname   string      `url:"tls_sni_hostname,omitempty"`
-	TLSCheckCert     Compatibool `url:"tls_check_cert,omitempty"`
-	TLSCertHostname  string      `url:"tls_cert_hostname,omitempty"`
-	MinTLSVersion    string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion    string      `url:"max_tls_version,omitempty"`
-	Healthcheck      string      `url:"healthcheck,omitempty"`
-	Type             PoolType    `url:"type,omitempty"`
-	OverrideHost     string      `url:"override_host,omitempty"`
+	Comment          string   `url:"comment,omitempty"`
+	Shield           string   `url:"shield,omitempty"`
+	RequestCondition string   `url:"request_condition,omitempty"`
+	MaxConnDefault   uint     `url:"max_conn_default,omitempty"`
+	ConnectTimeout   uint     `url:"connect_timeout,omitempty"`
+	FirstByteTimeout uint     `url:"first_byte_timeout,omitempty"`
+	Quorum           uint     `url:"quorum,omitempty"`
+	UseTLS           *bool    `url:"use_tls,omitempty,int"`
+	TLSCACert        string   `url:"tls_ca_cert,omitempty"`
+	TLSCiphers       string   `url:"tls_ciphers,omitempty"`
+	TLSClientKey     string   `url:"tls_client_key,omitempty"`
+	TLSClientCert    string   `url:"tls_client_cert,omitempty"`
+	TLSSNIHostname   string   `url:"tls_sni_hostname,omitempty"`
+	TLSCheckCert     *bool    `url:"tls_check_cert,omitempty,int"`
+	TLSCertHostname  string   `url:"tls_cert_hostname,omitempty"`
+	MinTLSVersion    string   `url:"min_tls_version,omitempty"`
+	MaxTLSVersion    string   `url:"max_tls_version,omitempty"`
+	Healthcheck      string   `url:"healthcheck,omitempty"`
+	Type             PoolType `url:"type,omitempty"`
+	OverrideHost     string   `url:"override_host,omitempty"`
 }
 
 // CreatePool creates a pool for a particular service and version.
@@ -214,27 +214,27 @@ type UpdatePoolInput struct {
 	Name string
 
 	// Optional fields.
-	NewName          *string      `url:"name,omitempty"`
-	Comment          *string      `url:"comment,omitempty"`
-	Shield           *string      `url:"shield,omitempty"`
-	RequestCondition *string      `url:"request_condition,omitempty"`
-	MaxConnDefault   *uint        `url:"max_conn_default,omitempty"`
-	ConnectTimeout   *uint        `url:"connect_timeout,omitempty"`
-	FirstByteTimeout *uint        `url:"first_byte_timeout,omitempty"`
-	Quorum           *uint        `url:"quorum,omitempty"`
-	UseTLS           *Compatibool `url:"use_tls,omitempty"`
-	TLSCACert        *string      `url:"tls_ca_cert,omitempty"`
-	TLSCiphers       *string      `url:"tls_ciphers,omitempty"`
-	TLSClientKey     *string      `url:"tls_client_key,omitempty"`
-	TLSClientCert    *string      `url:"tls_client_cert,omitempty"`
-	TLSSNIHostname   *string      `url:"tls_sni_hostname,omitempty"`
-	TLSCheckCert     *Compatibool `url:"tls_check_cert,omitempty"`
-	TLSCertHostname  *string      `url:"tls_cert_hostname,omitempty"`
-	MinTLSVersion    *string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion    *string      `url:"max_tls_version,omitempty"`
-	Healthcheck      *string      `url:"healthcheck,omitempty"`
-	Type             *PoolType    `url:"type,omitempty"`
-	OverrideHost     *string      `url:"override_host,omitempty"`
+	NewName          *string   `url:"name,omitempty"`
+	Comment          *string   `url:"comment,omitempty"`
+	Shield           *string   `url:"shield,omitempty"`
+	RequestCondition *string   `url:"request_condition,omitempty"`
+	MaxConnDefault   *uint     `url:"max_conn_default,omitempty"`
+	ConnectTimeout   *uint     `url:"connect_timeout,omitempty"`
+	FirstByteTimeout *uint     `url:"first_byte_timeout,omitempty"`
+	Quorum           *uint     `url:"quorum,omitempty"`
+	UseTLS           *bool     `url:"use_tls,omitempty,int"`
+	TLSCACert        *string   `url:"tls_ca_cert,omitempty"`
+	TLSCiphers       *string   `url:"tls_ciphers,omitempty"`
+	TLSClientKey     *string   `url:"tls_client_key,omitempty"`
+	TLSClientCert    *string   `url:"tls_client_cert,omitempty"`
+	TLSSNIHostname   *string   `url:"tls_sni_hostname,omitempty"`
+	TLSCheckCert     *bool     `url:"tls_check_cert,omitempty,int"`
+	TLSCertHostname  *string   `url:"tls_cert_hostname,omitempty"`
+	MinTLSVersion    *string   `url:"min_tls_version,omitempty"`
+	MaxTLSVersion    *string   `url:"max_tls_version,omitempty"`
+	Healthcheck      *string   `url:"healthcheck,omitempty"`
+	Type             *PoolType `url:"type,omitempty"`
+	OverrideHost     *string   `url:"override_host,omitempty"`
 }
 
 // UpdatePool updates a specufic pool for a particular service and version.
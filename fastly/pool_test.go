@@ -22,7 +22,7 @@ func TestClient_Pools(t *testing.T) {
 			Name:            "test_pool",
 			Comment:         "test pool",
 			Quorum:          50,
-			UseTLS:          true,
+			UseTLS:          Bool(true),
 			TLSCertHostname: "example.com",
 			Type:            PoolTypeRandom,
 		})
@@ -0,0 +1,51 @@
+package fastly
+
+import (
+	"fmt"
+)
+
+// ProductEnablement describes a product that is enabled on a service.
+type ProductEnablement struct {
+	Product struct {
+		ID string `mapstructure:"id"`
+	} `mapstructure:"product"`
+	Service struct {
+		ID string `mapstructure:"id"`
+	} `mapstructure:"service"`
+}
+
+// productEnablementRequest gets (GET) or enables (PUT) a product on a
+// service.
+func (c *Client) productEnablementRequest(verb, product, serviceID string) (*ProductEnablement, error) {
+	if serviceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	path := fmt.Sprintf("/enabled-products/v1/%s/services/%s", product, serviceID)
+	resp, err := c.Request(verb, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pe *ProductEnablement
+	if err := decodeBodyMap(resp.Body, &pe); err != nil {
+		return nil, err
+	}
+	return pe, nil
+}
+
+// disableProduct disables a product on a service.
+func (c *Client) disableProduct(product, serviceID string) error {
+	if serviceID == "" {
+		return ErrMissingServiceID
+	}
+
+	path := fmt.Sprintf("/enabled-products/v1/%s/services/%s", product, serviceID)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
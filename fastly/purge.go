@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -72,7 +73,7 @@ func (c *Client) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
 		return nil, ErrMissingKey
 	}
 
-	path := fmt.Sprintf("/service/%s/purge/%s", i.ServiceID, i.Key)
+	path := fmt.Sprintf("/service/%s/purge/%s", i.ServiceID, url.PathEscape(i.Key))
 
 	ro := new(RequestOptions)
 	ro.Parallel = true
@@ -85,7 +86,7 @@ func (c *Client) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
 		req.Header.Set("Fastly-Soft-Purge", "1")
 	}
 
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +135,7 @@ func (c *Client) PurgeKeys(i *PurgeKeysInput) (map[string]string, error) {
 
 	req.Header.Set("Surrogate-Key", strings.Join(i.Keys, " "))
 
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +165,7 @@ func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 		return nil, err
 	}
 
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -176,3 +177,41 @@ func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 	return r, nil
 
 }
+
+// PurgeClient is a client restricted to purge operations. It can be used with
+// purge-scoped API tokens, or without any token at all for URL purges on
+// services that do not require authenticated purging, so that cache-busting
+// services don't need full-privilege credentials.
+type PurgeClient struct {
+	client *Client
+}
+
+// NewPurgeClient creates a new purge-only client with the given token, which
+// may be empty. The endpoint defaults to the same value as NewClient.
+func NewPurgeClient(token string, opts ...ClientOption) (*PurgeClient, error) {
+	c, err := NewClient(token, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &PurgeClient{client: c}, nil
+}
+
+// Purge instantly purges an individual URL.
+func (p *PurgeClient) Purge(i *PurgeInput) (*Purge, error) {
+	return p.client.Purge(i)
+}
+
+// PurgeKey instantly purges a particular service of items tagged with a key.
+func (p *PurgeClient) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
+	return p.client.PurgeKey(i)
+}
+
+// PurgeKeys instantly purges a particular service of items tagged with a key.
+func (p *PurgeClient) PurgeKeys(i *PurgeKeysInput) (map[string]string, error) {
+	return p.client.PurgeKeys(i)
+}
+
+// PurgeAll instantly purges everything from a service.
+func (p *PurgeClient) PurgeAll(i *PurgeAllInput) (*Purge, error) {
+	return p.client.PurgeAll(i)
+}
@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -85,3 +87,67 @@ func TestClient_PurgeAll(t *testing.T) {
 		t.Error("bad status")
 	}
 }
+
+func TestPurgeClient_NoToken(t *testing.T) {
+	t.Parallel()
+
+	var key, path string
+	var hasKey bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasKey = r.Header[APIKeyHeader]
+		key = r.Header.Get(APIKeyHeader)
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"ok","id":"108-1391560174-974124"}`))
+	}))
+	defer ts.Close()
+
+	pc, err := NewPurgeClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	purge, err := pc.PurgeKey(&PurgeKeyInput{
+		ServiceID: testServiceID,
+		Key:       "foo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasKey {
+		t.Errorf("expected no %s header, got %q", APIKeyHeader, key)
+	}
+	if path != "/service/"+testServiceID+"/purge/foo" {
+		t.Errorf("bad path: %s", path)
+	}
+	if purge.Status != "ok" {
+		t.Errorf("bad status: %s", purge.Status)
+	}
+}
+
+func TestClient_PurgeKey_escapesKey(t *testing.T) {
+	t.Parallel()
+
+	var requestURI string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.RequestURI
+		w.Write([]byte(`{"status":"ok","id":"1"}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.PurgeKey(&PurgeKeyInput{
+		ServiceID: "foo",
+		Key:       "product/1 ü",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/service/foo/purge/product%2F1%20%C3%BC"; requestURI != want {
+		t.Errorf("bad request URI: got %q, want %q", requestURI, want)
+	}
+}
@@ -0,0 +1,62 @@
+package fastly
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(0, 0)
+	l := newRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+
+	// The initial burst is allowed immediately.
+	for i := 0; i < 2; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("request %d: expected no delay, got %s", i, d)
+		}
+	}
+
+	// The bucket is empty, so the next request has to wait for a token.
+	if d := l.reserve(); d != 500*time.Millisecond {
+		t.Errorf("expected 500ms delay, got %s", d)
+	}
+
+	// After two seconds the debt is repaid and the bucket refilled.
+	now = now.Add(2 * time.Second)
+	if d := l.reserve(); d != 0 {
+		t.Errorf("expected no delay, got %s", d)
+	}
+}
+
+func TestRateLimiter_wait(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter(1, 1)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx); err != context.Canceled {
+		t.Errorf("expected context canceled, got: %v", err)
+	}
+}
+
+func TestClient_RateLimit_disabled(t *testing.T) {
+	t.Parallel()
+
+	for _, rate := range []float64{0, -1} {
+		c, err := NewClient("", WithRateLimit(rate, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.limiter != nil {
+			t.Errorf("rate %v: expected no limiter", rate)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package fastly
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 )
 
 // RealtimeStatsResponse is a response from Fastly's real-time analytics endpoint
@@ -47,6 +50,10 @@ func (c *RTSClient) GetRealtimeStats(i *GetRealtimeStatsInput) (*RealtimeStatsRe
 
 // GetRealtimeStatsJSON fetches stats and decodes the response directly to the JSON struct dst.
 func (c *RTSClient) GetRealtimeStatsJSON(i *GetRealtimeStatsInput, dst interface{}) error {
+	return c.getRealtimeStatsJSON(context.Background(), i, dst)
+}
+
+func (c *RTSClient) getRealtimeStatsJSON(ctx context.Context, i *GetRealtimeStatsInput, dst interface{}) error {
 	if i.ServiceID == "" {
 		return ErrMissingServiceID
 	}
@@ -57,7 +64,7 @@ func (c *RTSClient) GetRealtimeStatsJSON(i *GetRealtimeStatsInput, dst interface
 		path = fmt.Sprintf("%s/limit/%d", path, i.Limit)
 	}
 
-	resp, err := c.client.Get(path, nil)
+	resp, err := c.client.Get(path, &RequestOptions{Context: ctx})
 	if err != nil {
 		return err
 	}
@@ -65,3 +72,109 @@ func (c *RTSClient) GetRealtimeStatsJSON(i *GetRealtimeStatsInput, dst interface
 
 	return json.NewDecoder(resp.Body).Decode(dst)
 }
+
+// DefaultRealtimeStatsRetryInterval is the default time SubscribeRealtimeStats
+// waits before retrying a service after a failed request.
+const DefaultRealtimeStatsRetryInterval = time.Second
+
+// RealtimeStatsUpdate is a realtime stats response for one of the services
+// subscribed to with SubscribeRealtimeStats.
+type RealtimeStatsUpdate struct {
+	ServiceID string
+	Stats     *RealtimeStatsResponse
+	// Err is set instead of Stats when the request for the service failed.
+	// The subscription keeps retrying the service.
+	Err error
+}
+
+// SubscribeRealtimeStatsInput is an input parameter to the
+// SubscribeRealtimeStats function.
+type SubscribeRealtimeStatsInput struct {
+	ServiceIDs []string
+	Limit      uint32
+	// RetryInterval is the time to wait after a failed request before
+	// retrying. It defaults to DefaultRealtimeStatsRetryInterval.
+	RetryInterval time.Duration
+}
+
+// SubscribeRealtimeStats follows the realtime stats of multiple services
+// concurrently and sends every response, keyed by service ID, on the returned
+// channel. Each service is polled in the rolling fashion described at
+// GetRealtimeStats. The channel is closed once ctx is done.
+func (c *RTSClient) SubscribeRealtimeStats(ctx context.Context, i *SubscribeRealtimeStatsInput) (<-chan *RealtimeStatsUpdate, error) {
+	if len(i.ServiceIDs) == 0 {
+		return nil, ErrMissingServiceIDs
+	}
+	for _, id := range i.ServiceIDs {
+		if id == "" {
+			return nil, ErrMissingServiceID
+		}
+	}
+
+	retry := i.RetryInterval
+	if retry <= 0 {
+		retry = DefaultRealtimeStatsRetryInterval
+	}
+
+	updates := make(chan *RealtimeStatsUpdate)
+	var wg sync.WaitGroup
+	for _, id := range i.ServiceIDs {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			c.followRealtimeStats(ctx, id, i.Limit, retry, updates)
+		}(id)
+	}
+	go func() {
+		wg.Wait()
+		close(updates)
+	}()
+
+	return updates, nil
+}
+
+// followRealtimeStats polls the realtime stats of a single service and sends
+// them to updates until ctx is done.
+func (c *RTSClient) followRealtimeStats(ctx context.Context, serviceID string, limit uint32, retry time.Duration, updates chan<- *RealtimeStatsUpdate) {
+	var timestamp uint64
+	for {
+		var resp interface{}
+		err := c.getRealtimeStatsJSON(ctx, &GetRealtimeStatsInput{
+			ServiceID: serviceID,
+			Timestamp: timestamp,
+			Limit:     limit,
+		}, &resp)
+		if ctx.Err() != nil {
+			return
+		}
+
+		var s *RealtimeStatsResponse
+		if err == nil {
+			err = decodeMap(resp, &s)
+		}
+
+		u := &RealtimeStatsUpdate{ServiceID: serviceID}
+		if err != nil {
+			u.Err = err
+		} else {
+			u.Stats = s
+		}
+		select {
+		case updates <- u:
+		case <-ctx.Done():
+			return
+		}
+
+		if err != nil {
+			t := time.NewTimer(retry)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return
+			}
+			continue
+		}
+		timestamp = s.Timestamp
+	}
+}
@@ -1,7 +1,16 @@
 package fastly
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestClient_GetRealtimeStats_validation(t *testing.T) {
@@ -55,3 +64,76 @@ func TestStatsClient_GetRealtimeStatsJSON(t *testing.T) {
 		t.Fatalf("got RenameTimestamp=%d, want nonzero", ret.RenameTimestamp)
 	}
 }
+
+func TestStatsClient_SubscribeRealtimeStats(t *testing.T) {
+	t.Parallel()
+
+	var failed int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// /v1/channel/<service>/ts/<timestamp>
+		parts := strings.Split(r.URL.Path, "/")
+		if len(parts) < 6 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		service := parts[3]
+		timestamp, _ := strconv.ParseUint(parts[5], 10, 64)
+
+		// The first request for service-b fails and has to be retried.
+		if service == "service-b" && atomic.CompareAndSwapInt32(&failed, 0, 1) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"Timestamp":%d,"Data":[{"recorded":%d,"aggregated":{"requests":1}}],"AggregateDelay":3}`, timestamp+1, timestamp)
+	}))
+	defer ts.Close()
+
+	c, err := NewRealtimeStatsClientForEndpoint("", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates, err := c.SubscribeRealtimeStats(ctx, &SubscribeRealtimeStatsInput{
+		ServiceIDs:    []string{"service-a", "service-b"},
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var errs int
+	timestamps := map[string][]uint64{}
+	for u := range updates {
+		if u.Err != nil {
+			if u.ServiceID != "service-b" {
+				t.Errorf("unexpected error for %s: %s", u.ServiceID, u.Err)
+			}
+			errs++
+			continue
+		}
+		timestamps[u.ServiceID] = append(timestamps[u.ServiceID], u.Stats.Timestamp)
+		if len(timestamps["service-a"]) >= 3 && len(timestamps["service-b"]) >= 3 {
+			cancel()
+		}
+	}
+
+	if errs != 1 {
+		t.Errorf("expected 1 error, got %d", errs)
+	}
+	for _, id := range []string{"service-a", "service-b"} {
+		// Each response's Timestamp is passed on to the next request.
+		if got := timestamps[id][:3]; !reflect.DeepEqual(got, []uint64{1, 2, 3}) {
+			t.Errorf("%s: expected timestamps [1 2 3], got %v", id, got)
+		}
+	}
+}
+
+func TestStatsClient_SubscribeRealtimeStats_validation(t *testing.T) {
+	_, err := testStatsClient.SubscribeRealtimeStats(context.Background(), &SubscribeRealtimeStatsInput{})
+	if err != ErrMissingServiceIDs {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -1,12 +1,19 @@
 package fastly
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
+// RequestIDHeader is the name of the header used to correlate a request with
+// the Fastly API's logs. It is sent with requests that have a RequestID and is
+// returned by the API in responses.
+const RequestIDHeader = "X-Request-Id"
+
 // RequestOptions is the list of options to pass to the request.
 type RequestOptions struct {
 	// Params is a map of key-value pairs that will be added to the Request.
@@ -22,6 +29,55 @@ type RequestOptions struct {
 
 	// Can this request run in parallel
 	Parallel bool
+
+	// Context, if set, is attached to the Request so that it can be cancelled
+	// or given a deadline by the caller. Typed calls use the Context set with
+	// Client.WithContext.
+	Context context.Context
+
+	// Token, if set, is used to authenticate the Request instead of the API
+	// key the Client was created with.
+	Token string
+
+	// RequestID, if set, is sent as the RequestIDHeader so that the request
+	// (and any retries of it) can be correlated with the API's logs. When
+	// empty, modifying requests use an ID from the Client's generator, if one
+	// was configured with WithRequestIDGenerator.
+	RequestID string
+
+	// IfNoneMatch, if set, is sent as the If-None-Match header so that GET
+	// requests for a resource whose ETag has not changed are answered with a
+	// 304 Not Modified response (see HTTPError.IsNotModified) instead of the
+	// full representation. The current ETag of a resource is available from
+	// the ETag header of the http.Response returned by Get. Typed calls that
+	// support conditional requests, such as GetService or GetVCL, have an
+	// IfNoneMatch field in their input and an ETag field in their result.
+	IfNoneMatch string
+
+	// Timeout, if non-zero, limits the time the Request may take, including
+	// reading the response body. It is applied in addition to any deadline on
+	// Context, and replaces the Timeout of the Client's HTTPClient, so that a
+	// slow request can be given longer than the HTTPClient allows. Typed
+	// calls use the Timeout set with Client.WithTimeout.
+	Timeout time.Duration
+
+	// OTP, if set, is sent as the OTPHeader for endpoints that require the
+	// one-time password of a user with two-factor authentication.
+	OTP string
+
+	// Sudo, if set, elevates the token of the Request (Token, or the
+	// client's) like Client.Sudo before the Request is sent, for sensitive
+	// endpoints that require it. The elevation uses the Context and Timeout
+	// of the Request.
+	Sudo *SudoInput
+
+	// endpoint, if set, replaces the Client's URL as the base of the request
+	// URL, see WithStatsEndpoint.
+	endpoint *url.URL
+
+	// noAPIKey, if set, sends the request without the client's API key,
+	// e.g. to a host that is not trusted with it.
+	noAPIKey bool
 }
 
 // RawRequest accepts a verb, URL, and RequestOptions struct and returns the
@@ -32,11 +88,31 @@ func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request,
 		ro = new(RequestOptions)
 	}
 
+	base := c.url
+	if ro.endpoint != nil {
+		base = ro.endpoint
+	}
+
 	// Append the path to the URL.
-	u := strings.TrimRight(c.url.String(), "/") + "/" + strings.TrimLeft(p, "/")
+	u := strings.TrimRight(base.String(), "/") + "/" + strings.TrimLeft(p, "/")
+
+	ctx := ro.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	body, bodyLength := ro.Body, ro.BodyLength
+	compressed := false
+	if _, ok := ro.Headers["Content-Encoding"]; !ok {
+		var err error
+		body, bodyLength, compressed, err = c.compressBody(body, bodyLength)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Create the request object.
-	request, err := http.NewRequest(verb, u, ro.Body)
+	request, err := http.NewRequestWithContext(ctx, verb, u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -48,21 +124,45 @@ func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request,
 	request.URL.RawQuery = params.Encode()
 
 	// Set the API key.
-	if len(c.apiKey) > 0 {
-		request.Header.Set(APIKeyHeader, c.apiKey)
+	key := c.getAPIKey()
+	if ro.Token != "" {
+		key = ro.Token
+	}
+	if len(key) > 0 && !ro.noAPIKey {
+		request.Header.Set(APIKeyHeader, key)
 	}
 
 	// Set the User-Agent.
-	request.Header.Set("User-Agent", UserAgent)
+	request.Header.Set("User-Agent", c.getUserAgent())
+
+	requestID := ro.RequestID
+	if requestID == "" && c.requestIDGenerator != nil && verb != "GET" && verb != "HEAD" {
+		requestID = c.requestIDGenerator()
+	}
+	if requestID != "" {
+		request.Header.Set(RequestIDHeader, requestID)
+	}
+
+	if ro.OTP != "" {
+		request.Header.Set(OTPHeader, ro.OTP)
+	}
+
+	if ro.IfNoneMatch != "" {
+		request.Header.Set("If-None-Match", ro.IfNoneMatch)
+	}
 
 	// Add any custom headers.
 	for k, v := range ro.Headers {
 		request.Header.Add(k, v)
 	}
 
+	if compressed {
+		request.Header.Set("Content-Encoding", "gzip")
+	}
+
 	// Add Content-Length if we have it.
-	if ro.BodyLength > 0 {
-		request.ContentLength = ro.BodyLength
+	if bodyLength > 0 {
+		request.ContentLength = bodyLength
 	}
 
 	return request, nil
@@ -86,14 +186,28 @@ func (c *Client) SimpleGet(target string) (*http.Response, error) {
 		return nil, err
 	}
 
-	if len(c.apiKey) > 0 {
-		request.Header.Set(APIKeyHeader, c.apiKey)
+	if key := c.getAPIKey(); len(key) > 0 {
+		request.Header.Set(APIKeyHeader, key)
 	}
-	request.Header.Set("User-Agent", UserAgent)
+	request.Header.Set("User-Agent", c.getUserAgent())
 
-	resp, err := checkResp(c.HTTPClient.Do(request))
+	resp, err := c.do(request)
 	if err != nil {
 		return resp, err
 	}
 	return resp, nil
 }
+
+// cancelOnClose wraps a response body so that the context associated with a
+// request timeout is only released once the body has been consumed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the underlying body and releases the request context.
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
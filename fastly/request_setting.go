@@ -116,15 +116,15 @@ type CreateRequestSettingInput struct {
 	ServiceVersion int
 
 	Name             string               `url:"name,omitempty"`
-	ForceMiss        Compatibool          `url:"force_miss,omitempty"`
-	ForceSSL         Compatibool          `url:"force_ssl,omitempty"`
+	ForceMiss        *bool                `url:"force_miss,omitempty,int"`
+	ForceSSL         *bool                `url:"force_ssl,omitempty,int"`
 	Action           RequestSettingAction `url:"action,omitempty"`
-	BypassBusyWait   Compatibool          `url:"bypass_busy_wait,omitempty"`
+	BypassBusyWait   *bool                `url:"bypass_busy_wait,omitempty,int"`
 	MaxStaleAge      *uint                `url:"max_stale_age,omitempty"`
 	HashKeys         string               `url:"hash_keys,omitempty"`
 	XForwardedFor    RequestSettingXFF    `url:"xff,omitempty"`
-	TimerSupport     Compatibool          `url:"timer_support,omitempty"`
-	GeoHeaders       Compatibool          `url:"geo_headers,omitempty"`
+	TimerSupport     *bool                `url:"timer_support,omitempty,int"`
+	GeoHeaders       *bool                `url:"geo_headers,omitempty,int"`
 	DefaultHost      string               `url:"default_host,omitempty"`
 	RequestCondition string               `url:"request_condition,omitempty"`
 }
@@ -205,15 +205,15 @@ type UpdateRequestSettingInput struct {
 	Name string
 
 	NewName          *string              `url:"name,omitempty"`
-	ForceMiss        *Compatibool         `url:"force_miss,omitempty"`
-	ForceSSL         *Compatibool         `url:"force_ssl,omitempty"`
+	ForceMiss        *bool                `url:"force_miss,omitempty,int"`
+	ForceSSL         *bool                `url:"force_ssl,omitempty,int"`
 	Action           RequestSettingAction `url:"action,omitempty"`
-	BypassBusyWait   *Compatibool         `url:"bypass_busy_wait,omitempty"`
+	BypassBusyWait   *bool                `url:"bypass_busy_wait,omitempty,int"`
 	MaxStaleAge      *uint                `url:"max_stale_age,omitempty"`
 	HashKeys         *string              `url:"hash_keys,omitempty"`
 	XForwardedFor    RequestSettingXFF    `url:"xff,omitempty"`
-	TimerSupport     *Compatibool         `url:"timer_support,omitempty"`
-	GeoHeaders       *Compatibool         `url:"geo_headers,omitempty"`
+	TimerSupport     *bool                `url:"timer_support,omitempty,int"`
+	GeoHeaders       *bool                `url:"geo_headers,omitempty,int"`
 	DefaultHost      *string              `url:"default_host,omitempty"`
 	RequestCondition *string              `url:"request_condition,omitempty"`
 }
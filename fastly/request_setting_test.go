@@ -20,15 +20,15 @@ func TestClient_RequestSettings(t *testing.T) {
 			ServiceID:      testServiceID,
 			ServiceVersion: tv.Number,
 			Name:           "test-request-setting",
-			ForceMiss:      true,
-			ForceSSL:       true,
+			ForceMiss:      Bool(true),
+			ForceSSL:       Bool(true),
 			Action:         RequestSettingActionLookup,
-			BypassBusyWait: true,
+			BypassBusyWait: Bool(true),
 			MaxStaleAge:    Uint(30),
 			HashKeys:       "a,b,c",
 			XForwardedFor:  RequestSettingXFFLeave,
-			TimerSupport:   true,
-			GeoHeaders:     true,
+			TimerSupport:   Bool(true),
+			GeoHeaders:     Bool(true),
 			DefaultHost:    "example.com",
 		})
 	})
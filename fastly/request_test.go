@@ -1,9 +1,20 @@
 package fastly
 
 import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestClient_RawRequest(t *testing.T) {
@@ -53,3 +64,578 @@ func TestClient_RawRequest(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_RequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(2 * time.Second):
+			}
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	c, err := NewClientForEndpoint("", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Get("/slow", &RequestOptions{Timeout: 50 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got: %v", err)
+	}
+
+	// The body must remain readable after the request returns.
+	resp, err := c.Get("/fast", &RequestOptions{Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resp.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != "ok" {
+		t.Errorf("bad body: %q", body)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.Get("/fast", &RequestOptions{Context: ctx})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled, got: %v", err)
+	}
+}
+
+func TestClient_WithTimeout(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.Write([]byte(`{"id":"abc","name":"slow"}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The HTTPClient's Timeout applies by default.
+	if _, err := c.GetService(&GetServiceInput{ID: "abc"}); err == nil {
+		t.Fatal("expected the HTTPClient timeout to expire")
+	}
+
+	// A longer request Timeout takes precedence over it.
+	s, err := c.WithTimeout(2 * time.Second).GetService(&GetServiceInput{ID: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "slow" {
+		t.Errorf("bad service: %v", s)
+	}
+
+	_, err = c.WithTimeout(2*time.Second).Get("/service/abc", &RequestOptions{Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request options to take precedence, got: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.WithTimeout(2 * time.Second).WithContext(ctx).GetService(&GetServiceInput{ID: "abc"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled, got: %v", err)
+	}
+}
+
+func TestClient_IfNoneMatch(t *testing.T) {
+	t.Parallel()
+
+	const etag = `"abc123"`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClientForEndpoint("", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := c.Get("/service/foo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.Header.Get("ETag") != etag {
+		t.Fatalf("bad etag: %q", resp.Header.Get("ETag"))
+	}
+
+	_, err = c.Get("/service/foo", &RequestOptions{IfNoneMatch: etag})
+	herr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected *HTTPError, got: %v", err)
+	}
+	if !herr.IsNotModified() {
+		t.Errorf("expected not modified, got: %d", herr.StatusCode)
+	}
+
+	// Typed calls expose the ETag and accept it back.
+	s, err := c.GetService(&GetServiceInput{ID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ETag != etag {
+		t.Fatalf("bad service etag: %q", s.ETag)
+	}
+	_, err = c.GetService(&GetServiceInput{ID: "foo", IfNoneMatch: s.ETag})
+	if herr, ok := err.(*HTTPError); !ok || !herr.IsNotModified() {
+		t.Errorf("expected not modified, got: %v", err)
+	}
+
+	vcl, err := c.GetVCL(&GetVCLInput{ServiceID: "foo", ServiceVersion: 1, Name: "main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vcl.ETag != etag {
+		t.Fatalf("bad VCL etag: %q", vcl.ETag)
+	}
+	_, err = c.GetVCL(&GetVCLInput{ServiceID: "foo", ServiceVersion: 1, Name: "main", IfNoneMatch: vcl.ETag})
+	if herr, ok := err.(*HTTPError); !ok || !herr.IsNotModified() {
+		t.Errorf("expected not modified, got: %v", err)
+	}
+}
+
+func TestClient_TokenOverride(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientForEndpoint("client-token", DefaultEndpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := c.RawRequest("GET", "/service", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k := r.Header.Get(APIKeyHeader); k != "client-token" {
+		t.Errorf("bad key: %q", k)
+	}
+
+	r, err = c.RawRequest("GET", "/service", &RequestOptions{Token: "request-token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k := r.Header.Get(APIKeyHeader); k != "request-token" {
+		t.Errorf("bad key: %q", k)
+	}
+
+	tc := c.WithToken("other-token")
+	r, err = tc.RawRequest("GET", "/service", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k := r.Header.Get(APIKeyHeader); k != "other-token" {
+		t.Errorf("bad key: %q", k)
+	}
+	if tc.HTTPClient != c.HTTPClient {
+		t.Error("expected the HTTP client to be shared")
+	}
+
+	// The original client must be unaffected.
+	r, err = c.RawRequest("GET", "/service", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k := r.Header.Get(APIKeyHeader); k != "client-token" {
+		t.Errorf("bad key: %q", k)
+	}
+}
+
+func TestClient_RequestID(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientForEndpoint("", DefaultEndpoint, WithRequestIDGenerator(func() string {
+		return "generated-id"
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		verb     string
+		ro       *RequestOptions
+		expected string
+	}{
+		{"GET", nil, ""},
+		{"POST", nil, "generated-id"},
+		{"PUT", &RequestOptions{RequestID: "caller-id"}, "caller-id"},
+		{"GET", &RequestOptions{RequestID: "caller-id"}, "caller-id"},
+	} {
+		r, err := c.RawRequest(tc.verb, "/service", tc.ro)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id := r.Header.Get(RequestIDHeader); id != tc.expected {
+			t.Errorf("%s: expected request ID %q, got %q", tc.verb, tc.expected, id)
+		}
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	var got *http.Request
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		got = r
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("api-key",
+		WithEndpoint(ts.URL),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+	resp, err := c.Do(ctx, "PUT", "/new/endpoint", RequestOptions{
+		Params:  map[string]string{"filter": "x"},
+		Headers: map[string]string{"Content-Type": "application/json"},
+		Body:    strings.NewReader(`{"a":1}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if calls != 2 {
+		t.Errorf("expected the request to be retried, got %d calls", calls)
+	}
+	if got.URL.Path != "/new/endpoint" || got.URL.Query().Get("filter") != "x" {
+		t.Errorf("bad URL: %s", got.URL)
+	}
+	if k := got.Header.Get(APIKeyHeader); k != "api-key" {
+		t.Errorf("bad key: %q", k)
+	}
+	if ua := got.Header.Get("User-Agent"); !strings.HasPrefix(ua, "FastlyGo/") {
+		t.Errorf("bad User-Agent: %q", ua)
+	}
+	if body != `{"a":1}` {
+		t.Errorf("bad body: %q", body)
+	}
+	if resp.Request.Context().Value(ctxKey{}) != "value" {
+		t.Error("context was not attached to the request")
+	}
+
+	// Errors are returned as an *HTTPError.
+	_, err = c.Do(ctx, "GET", "/missing", RequestOptions{})
+	var herr *HTTPError
+	if !errors.As(err, &herr) {
+		t.Errorf("expected an *HTTPError, got %v", err)
+	}
+}
+
+func TestClient_WithResponseMetadata(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-"+r.Method)
+		if r.Method == "GET" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"msg":"Record not found"}`))
+			return
+		}
+		w.Header().Set("Fastly-RateLimit-Remaining", "42")
+		w.Header().Set("Fastly-RateLimit-Reset", "1600000000")
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var meta ResponseMetadata
+	mc := c.WithResponseMetadata(&meta)
+
+	if err := mc.DeleteGzip(&DeleteGzipInput{ServiceID: "s", ServiceVersion: 1, Name: "g"}); err != nil {
+		t.Fatal(err)
+	}
+	if meta.StatusCode != http.StatusOK {
+		t.Errorf("bad status code: %d", meta.StatusCode)
+	}
+	if meta.RequestID != "req-DELETE" {
+		t.Errorf("bad request ID: %q", meta.RequestID)
+	}
+	if meta.RateLimitRemaining != 42 {
+		t.Errorf("bad rate limit remaining: %d", meta.RateLimitRemaining)
+	}
+	if !meta.RateLimitReset.Equal(time.Unix(1600000000, 0)) {
+		t.Errorf("bad rate limit reset: %s", meta.RateLimitReset)
+	}
+
+	_, err = mc.GetGzip(&GetGzipInput{ServiceID: "s", ServiceVersion: 1, Name: "g"})
+	var herr *HTTPError
+	if !errors.As(err, &herr) {
+		t.Fatalf("expected an HTTPError, got %v", err)
+	}
+	if meta.StatusCode != http.StatusNotFound || meta.RequestID != "req-GET" {
+		t.Errorf("bad metadata: %d %q", meta.StatusCode, meta.RequestID)
+	}
+	if meta.RateLimitRemaining != -1 || !meta.RateLimitReset.IsZero() {
+		t.Errorf("expected no rate limit information, got %d %s", meta.RateLimitRemaining, meta.RateLimitReset)
+	}
+
+	// The original client must not record anything.
+	meta = ResponseMetadata{}
+	if err := c.DeleteGzip(&DeleteGzipInput{ServiceID: "s", ServiceVersion: 1, Name: "g"}); err != nil {
+		t.Fatal(err)
+	}
+	if meta.StatusCode != 0 {
+		t.Errorf("expected no metadata, got %d", meta.StatusCode)
+	}
+}
+
+// TestClient_WithResponseMetadata_concurrent checks that requests sent
+// concurrently through Bulk record their metadata without a data race. It is
+// meant to be run with -race, see make test-race.
+func TestClient_WithResponseMetadata_concurrent(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-"+strings.TrimPrefix(r.URL.Path, "/service/s/version/1/gzip/"))
+		w.Write([]byte(`{"name":"g"}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var meta ResponseMetadata
+	mc := c.WithResponseMetadata(&meta)
+
+	names := make([]string, 20)
+	for i := range names {
+		names[i] = fmt.Sprint(i)
+	}
+	err = Bulk(context.Background(), mc, names, 10, func(_ context.Context, name string) error {
+		_, err := mc.GetGzip(&GetGzipInput{ServiceID: "s", ServiceVersion: 1, Name: name})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.StatusCode != http.StatusOK || !strings.HasPrefix(meta.RequestID, "req-") {
+		t.Errorf("bad metadata: %d %q", meta.StatusCode, meta.RequestID)
+	}
+}
+
+func TestClient_RequestCompression(t *testing.T) {
+	t.Parallel()
+
+	type received struct {
+		encoding string
+		length   int64
+		body     string
+	}
+	got := make(chan received, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			body = zr
+		}
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			t.Error(err)
+		}
+		got <- received{r.Header.Get("Content-Encoding"), r.ContentLength, string(b)}
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithRequestCompression(64))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	large := strings.Repeat("x", 1000)
+	for _, tc := range []struct {
+		name     string
+		ro       *RequestOptions
+		encoding string
+	}{
+		{"small", &RequestOptions{Body: strings.NewReader("small"), BodyLength: 5}, ""},
+		{"empty", &RequestOptions{Body: strings.NewReader("")}, ""},
+		{"large", &RequestOptions{Body: strings.NewReader(large), BodyLength: 1000}, "gzip"},
+		{"stream", &RequestOptions{Body: ioutil.NopCloser(strings.NewReader(large))}, "gzip"},
+		{"encoded", &RequestOptions{Body: strings.NewReader(large), Headers: map[string]string{"Content-Encoding": "identity"}}, "identity"},
+	} {
+		resp, err := c.Request("PUT", "/upload", tc.ro)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		resp.Body.Close()
+
+		r := <-got
+		if r.encoding != tc.encoding {
+			t.Errorf("%s: expected Content-Encoding %q, got %q", tc.name, tc.encoding, r.encoding)
+		}
+		if r.encoding == "gzip" && r.length >= 1000 {
+			t.Errorf("%s: expected a compressed body, got length %d", tc.name, r.length)
+		}
+		if tc.encoding != "" && r.body != large {
+			t.Errorf("%s: bad body: %q", tc.name, r.body)
+		}
+	}
+}
+
+func TestClient_TokenRefresher(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var keys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		mu.Lock()
+		keys = append(keys, r.Header.Get(APIKeyHeader)+":"+string(b))
+		mu.Unlock()
+		if r.Header.Get(APIKeyHeader) != "fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer ts.Close()
+
+	var refreshes int
+	c, err := NewClient("expired-token", WithEndpoint(ts.URL), WithTokenRefresher(func(ctx context.Context) (string, error) {
+		refreshes++
+		return "fresh-token", nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.PutForm("/service/foo", struct {
+		Name string `url:"name"`
+	}{"bar"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("/service/foo", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests authenticated with another token are not refreshed.
+	if _, err := c.Get("/service/foo", &RequestOptions{Token: "other-token"}); err == nil {
+		t.Error("expected an error for another token")
+	}
+	if _, err := c.WithToken("another-token").Get("/service/foo", nil); err == nil {
+		t.Error("expected an error for a copy with another token")
+	}
+
+	if refreshes != 1 {
+		t.Errorf("expected 1 refresh, got %d", refreshes)
+	}
+	expected := []string{
+		"expired-token:name=bar",
+		"fresh-token:name=bar",
+		"fresh-token:",
+		"other-token:",
+		"another-token:",
+	}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected requests %q, got %q", expected, keys)
+	}
+}
+
+func TestClient_RequestSudo(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		requests = append(requests, r.Method+" "+r.URL.Path+" otp="+r.Header.Get(OTPHeader)+" user="+r.PostForm.Get("username")+" key="+r.Header.Get(APIKeyHeader))
+		if r.URL.Path == "/sudo" {
+			w.Write([]byte(`{"expiry_time":"2022-06-20T09:10:32+00:00"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("client-token", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sudo := &SudoInput{Username: "user@example.com", Password: "secret", OTP: "123456"}
+	resp, err := c.Do(context.Background(), "DELETE", "/tokens/abc", RequestOptions{
+		OTP:   "654321",
+		Sudo:  sudo,
+		Token: "request-token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	expected := []string{
+		"POST /sudo otp=123456 user=user@example.com key=request-token",
+		"DELETE /tokens/abc otp=654321 user= key=request-token",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests)
+	}
+
+	// The sudo request is cancelled with the request it protects.
+	requests = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.Do(ctx, "DELETE", "/tokens/abc", RequestOptions{Sudo: sudo})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests, got %q", requests)
+	}
+}
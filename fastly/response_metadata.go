@@ -0,0 +1,84 @@
+package fastly
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ResponseMetadata describes the HTTP response to an API call, for debugging
+// and for tracking the rate limit quota. It is filled in by a client returned
+// by WithResponseMetadata.
+type ResponseMetadata struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header is the header of the response.
+	Header http.Header
+
+	// RequestID is the ID of the request from the RequestIDHeader of the
+	// response, to correlate it with the API's logs.
+	RequestID string
+
+	// RateLimitRemaining and RateLimitReset are the values of the
+	// Fastly-RateLimit-Remaining and Fastly-RateLimit-Reset headers, which
+	// are only returned for modifying requests. RateLimitRemaining is -1 and
+	// RateLimitReset the zero time when the headers are missing.
+	RateLimitRemaining int
+	RateLimitReset     time.Time
+}
+
+// WithResponseMetadata returns a copy of the client that records the metadata
+// of the last response it receives in m, including unsuccessful responses
+// returned as an *HTTPError, e.g.
+//
+//	var meta fastly.ResponseMetadata
+//	service, err := client.WithResponseMetadata(&meta).GetService(i)
+//
+// Calls that make several requests, such as the paginated ListAll functions,
+// record the last of them. Calls that send requests concurrently, such as
+// ExportServiceConfig and CompareVersions, record the one that completes
+// last. m is written under a lock and may be read once the call returns, but
+// the copy should not be used for concurrent calls, as they would overwrite
+// each other's metadata; use one copy (and ResponseMetadata) per call
+// instead. Like WithToken, the copy shares the HTTPClient and all other
+// configuration with the original client.
+func (c *Client) WithResponseMetadata(m *ResponseMetadata) *Client {
+	n := c.clone()
+	n.responseMetadata = &responseMetadataRecorder{m: m}
+	return n
+}
+
+// responseMetadataRecorder serializes the writes to the ResponseMetadata of
+// a client, which may receive responses concurrently, e.g. through Bulk.
+type responseMetadataRecorder struct {
+	mu sync.Mutex
+	m  *ResponseMetadata
+}
+
+// recordResponseMetadata stores the metadata of resp in the client's
+// ResponseMetadata, if any.
+func (c *Client) recordResponseMetadata(resp *http.Response) {
+	r := c.responseMetadata
+	if r == nil || r.m == nil || resp == nil {
+		return
+	}
+
+	m := ResponseMetadata{
+		StatusCode:         resp.StatusCode,
+		Header:             resp.Header,
+		RequestID:          resp.Header.Get(RequestIDHeader),
+		RateLimitRemaining: -1,
+	}
+	if v, err := strconv.Atoi(resp.Header.Get("Fastly-RateLimit-Remaining")); err == nil {
+		m.RateLimitRemaining = v
+	}
+	if v, err := strconv.ParseInt(resp.Header.Get("Fastly-RateLimit-Reset"), 10, 64); err == nil {
+		m.RateLimitReset = time.Unix(v, 0)
+	}
+
+	r.mu.Lock()
+	*r.m = m
+	r.mu.Unlock()
+}
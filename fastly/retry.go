@@ -0,0 +1,167 @@
+package fastly
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetryMinBackoff is the default delay before the first retry.
+	DefaultRetryMinBackoff = 500 * time.Millisecond
+
+	// DefaultRetryMaxBackoff is the default upper bound of the delay between
+	// retries.
+	DefaultRetryMaxBackoff = 30 * time.Second
+
+	// DefaultRetryBudgetWindow is the default period over which a retry
+	// budget applies.
+	DefaultRetryBudgetWindow = time.Minute
+)
+
+// RetryPolicy controls how failed requests are retried by a Client.
+//
+// Only idempotent requests (GET, HEAD, PUT, DELETE and OPTIONS) are retried,
+// and only when the request failed at the transport level or the API
+// responded with a 429 Too Many Requests or a 5xx server error (other than
+// 501 Not Implemented). When a 429 or 503 response carries a Retry-After
+// header, the request is retried after the delay it asks for rather than the
+// exponential backoff, or not at all if that delay exceeds MaxBackoff.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry. It is doubled for each
+	// subsequent retry. DefaultRetryMinBackoff is used when zero.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the upper bound of the delay between retries.
+	// DefaultRetryMaxBackoff is used when zero.
+	MaxBackoff time.Duration
+
+	// Budget, if non-zero, caps the total number of retries the client
+	// performs (across all requests) within each BudgetWindow. Once the
+	// budget is exhausted failed requests are returned without retrying.
+	Budget int
+
+	// BudgetWindow is the period over which Budget applies.
+	// DefaultRetryBudgetWindow is used when zero.
+	BudgetWindow time.Duration
+}
+
+// backoff returns the delay before the given (zero-indexed) retry.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	min, max := p.MinBackoff, p.maxBackoff()
+	if min <= 0 {
+		min = DefaultRetryMinBackoff
+	}
+
+	d := min
+	for i := 0; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// maxBackoff returns the upper bound of the delay between retries.
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return DefaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// retryDelay returns the delay before the given (zero-indexed) retry of a
+// request that failed with err. The delay requested by the API with a
+// Retry-After header is used instead of the exponential backoff; ok is false
+// if it exceeds MaxBackoff, in which case the request is not retried.
+func (p *RetryPolicy) retryDelay(retry int, err error) (d time.Duration, ok bool) {
+	if herr, isHTTP := err.(*HTTPError); isHTTP && herr.RetryAfter > 0 {
+		return herr.RetryAfter, herr.RetryAfter <= p.maxBackoff()
+	}
+	return p.backoff(retry), true
+}
+
+// parseRetryAfter returns the delay requested by the value of a Retry-After
+// header, which is either a number of seconds or an HTTP date, or 0 if the
+// value is missing or invalid.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// retryable reports whether the given request may be retried after it
+// resulted in resp and err.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case "GET", "HEAD", "PUT", "DELETE", "OPTIONS":
+	default:
+		return false
+	}
+
+	// A body that cannot be rewound cannot be sent again.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	// The caller gave up, so there is no point in trying again.
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if _, ok := err.(*HTTPError); !ok && err != nil {
+		return true
+	}
+
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// waitRetry sleeps for the given duration unless the request context is
+// cancelled first, and prepares the request body to be sent again.
+func waitRetry(req *http.Request, resp *http.Response, d time.Duration) error {
+	if resp != nil && resp.Body != nil {
+		io.Copy(ioutil.Discard, resp.Body) // #nosec G104
+		resp.Body.Close()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-t.C:
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		req.Body = body
+	}
+	return nil
+}
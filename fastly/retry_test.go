@@ -0,0 +1,139 @@
+package fastly
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_Retries(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	var logs bytes.Buffer
+	c, err := NewClient("",
+		WithEndpoint(ts.URL),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond}),
+		WithLogger(log.New(&logs, "", 0)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Put("/service/foo", nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("expected 3 calls, got %d", n)
+	}
+	if !strings.Contains(logs.String(), "retrying PUT /service/foo") {
+		t.Errorf("bad logs: %q", logs.String())
+	}
+
+	// Non-idempotent requests are never retried.
+	atomic.StoreInt32(&calls, 0)
+	if _, err := c.Post("/service", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	t.Parallel()
+
+	p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for retry, expected := range []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+	} {
+		if d := p.backoff(retry); d != expected {
+			t.Errorf("retry %d: expected %s, got %s", retry, expected, d)
+		}
+	}
+}
+
+func TestClient_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	var retryAfter atomic.Value
+	retryAfter.Store("1")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", retryAfter.Load().(string))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// MinBackoff alone would retry immediately: the delay must come from
+	// the Retry-After header.
+	c, err := NewClient("",
+		WithEndpoint(ts.URL),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 1, MinBackoff: time.Nanosecond, MaxBackoff: 2 * time.Second}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err := c.Get("/service/foo", nil); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < time.Second {
+		t.Errorf("expected to wait for the Retry-After delay, waited %s", d)
+	}
+
+	// A delay longer than MaxBackoff is not waited for.
+	atomic.StoreInt32(&calls, 0)
+	retryAfter.Store("60")
+	_, err = c.Get("/service/foo", nil)
+	herr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected an HTTPError, got %v", err)
+	}
+	if herr.RetryAfter != time.Minute {
+		t.Errorf("expected a RetryAfter of 1m, got %s", herr.RetryAfter)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 6, 20, 9, 5, 32, 0, time.UTC)
+	for _, tc := range []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"30", 30 * time.Second},
+		{"-1", 0},
+		{"Mon, 20 Jun 2022 09:06:32 GMT", time.Minute},
+		{"Mon, 20 Jun 2022 09:04:32 GMT", 0},
+		{"soon", 0},
+	} {
+		if d := parseRetryAfter(tc.value, now); d != tc.expected {
+			t.Errorf("%q: expected %s, got %s", tc.value, tc.expected, d)
+		}
+	}
+}
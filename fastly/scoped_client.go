@@ -0,0 +1,397 @@
+package fastly
+
+// The scoped clients below group the methods of an API area, so that the
+// methods of an area are easy to find and an area can be replaced by a mock
+// behind an interface declared by the caller. Their methods are the methods of
+// Client with the same names and behave identically; the Client methods remain
+// available.
+
+// WAFClient groups the methods of the Web Application Firewall API: WAFs and their versions, active rules, rule exclusions, the rules catalog and configuration sets. It is returned by Client.WAF.
+type WAFClient struct {
+	client *Client
+}
+
+// WAF returns a WAFClient that sends its requests with c.
+func (c *Client) WAF() *WAFClient {
+	return &WAFClient{client: c}
+}
+
+// ListWAFs calls Client.ListWAFs.
+func (w *WAFClient) ListWAFs(i *ListWAFsInput) (*WAFResponse, error) {
+	return w.client.ListWAFs(i)
+}
+
+// ListAllWAFs calls Client.ListAllWAFs.
+func (w *WAFClient) ListAllWAFs(i *ListAllWAFsInput) (*WAFResponse, error) {
+	return w.client.ListAllWAFs(i)
+}
+
+// CreateWAF calls Client.CreateWAF.
+func (w *WAFClient) CreateWAF(i *CreateWAFInput) (*WAF, error) {
+	return w.client.CreateWAF(i)
+}
+
+// GetWAF calls Client.GetWAF.
+func (w *WAFClient) GetWAF(i *GetWAFInput) (*WAF, error) {
+	return w.client.GetWAF(i)
+}
+
+// UpdateWAF calls Client.UpdateWAF.
+func (w *WAFClient) UpdateWAF(i *UpdateWAFInput) (*WAF, error) {
+	return w.client.UpdateWAF(i)
+}
+
+// DeleteWAF calls Client.DeleteWAF.
+func (w *WAFClient) DeleteWAF(i *DeleteWAFInput) error {
+	return w.client.DeleteWAF(i)
+}
+
+// ListWAFVersions calls Client.ListWAFVersions.
+func (w *WAFClient) ListWAFVersions(i *ListWAFVersionsInput) (*WAFVersionResponse, error) {
+	return w.client.ListWAFVersions(i)
+}
+
+// ListAllWAFVersions calls Client.ListAllWAFVersions.
+func (w *WAFClient) ListAllWAFVersions(i *ListAllWAFVersionsInput) (*WAFVersionResponse, error) {
+	return w.client.ListAllWAFVersions(i)
+}
+
+// GetWAFVersion calls Client.GetWAFVersion.
+func (w *WAFClient) GetWAFVersion(i *GetWAFVersionInput) (*WAFVersion, error) {
+	return w.client.GetWAFVersion(i)
+}
+
+// UpdateWAFVersion calls Client.UpdateWAFVersion.
+func (w *WAFClient) UpdateWAFVersion(i *UpdateWAFVersionInput) (*WAFVersion, error) {
+	return w.client.UpdateWAFVersion(i)
+}
+
+// LockWAFVersion calls Client.LockWAFVersion.
+func (w *WAFClient) LockWAFVersion(i *LockWAFVersionInput) (*WAFVersion, error) {
+	return w.client.LockWAFVersion(i)
+}
+
+// CloneWAFVersion calls Client.CloneWAFVersion.
+func (w *WAFClient) CloneWAFVersion(i *CloneWAFVersionInput) (*WAFVersion, error) {
+	return w.client.CloneWAFVersion(i)
+}
+
+// DeployWAFVersion calls Client.DeployWAFVersion.
+func (w *WAFClient) DeployWAFVersion(i *DeployWAFVersionInput) error {
+	return w.client.DeployWAFVersion(i)
+}
+
+// CreateEmptyWAFVersion calls Client.CreateEmptyWAFVersion.
+func (w *WAFClient) CreateEmptyWAFVersion(i *CreateEmptyWAFVersionInput) (*WAFVersion, error) {
+	return w.client.CreateEmptyWAFVersion(i)
+}
+
+// ListWAFActiveRules calls Client.ListWAFActiveRules.
+func (w *WAFClient) ListWAFActiveRules(i *ListWAFActiveRulesInput) (*WAFActiveRuleResponse, error) {
+	return w.client.ListWAFActiveRules(i)
+}
+
+// ListAllWAFActiveRules calls Client.ListAllWAFActiveRules.
+func (w *WAFClient) ListAllWAFActiveRules(i *ListAllWAFActiveRulesInput) (*WAFActiveRuleResponse, error) {
+	return w.client.ListAllWAFActiveRules(i)
+}
+
+// CreateWAFActiveRules calls Client.CreateWAFActiveRules.
+func (w *WAFClient) CreateWAFActiveRules(i *CreateWAFActiveRulesInput) ([]*WAFActiveRule, error) {
+	return w.client.CreateWAFActiveRules(i)
+}
+
+// BatchModificationWAFActiveRules calls Client.BatchModificationWAFActiveRules.
+func (w *WAFClient) BatchModificationWAFActiveRules(i *BatchModificationWAFActiveRulesInput) ([]*WAFActiveRule, error) {
+	return w.client.BatchModificationWAFActiveRules(i)
+}
+
+// ImportWAFActiveRules calls Client.ImportWAFActiveRules.
+func (w *WAFClient) ImportWAFActiveRules(i *ImportWAFActiveRulesInput) ([]*WAFActiveRule, error) {
+	return w.client.ImportWAFActiveRules(i)
+}
+
+// DeleteWAFActiveRules calls Client.DeleteWAFActiveRules.
+func (w *WAFClient) DeleteWAFActiveRules(i *DeleteWAFActiveRulesInput) error {
+	return w.client.DeleteWAFActiveRules(i)
+}
+
+// ListWAFRuleExclusions calls Client.ListWAFRuleExclusions.
+func (w *WAFClient) ListWAFRuleExclusions(i *ListWAFRuleExclusionsInput) (*WAFRuleExclusionResponse, error) {
+	return w.client.ListWAFRuleExclusions(i)
+}
+
+// ListAllWAFRuleExclusions calls Client.ListAllWAFRuleExclusions.
+func (w *WAFClient) ListAllWAFRuleExclusions(i *ListAllWAFRuleExclusionsInput) (*WAFRuleExclusionResponse, error) {
+	return w.client.ListAllWAFRuleExclusions(i)
+}
+
+// CreateWAFRuleExclusion calls Client.CreateWAFRuleExclusion.
+func (w *WAFClient) CreateWAFRuleExclusion(i *CreateWAFRuleExclusionInput) (*WAFRuleExclusion, error) {
+	return w.client.CreateWAFRuleExclusion(i)
+}
+
+// UpdateWAFRuleExclusion calls Client.UpdateWAFRuleExclusion.
+func (w *WAFClient) UpdateWAFRuleExclusion(i *UpdateWAFRuleExclusionInput) (*WAFRuleExclusion, error) {
+	return w.client.UpdateWAFRuleExclusion(i)
+}
+
+// DeleteWAFRuleExclusion calls Client.DeleteWAFRuleExclusion.
+func (w *WAFClient) DeleteWAFRuleExclusion(i *DeleteWAFRuleExclusionInput) error {
+	return w.client.DeleteWAFRuleExclusion(i)
+}
+
+// ListWAFRules calls Client.ListWAFRules.
+func (w *WAFClient) ListWAFRules(i *ListWAFRulesInput) (*WAFRuleResponse, error) {
+	return w.client.ListWAFRules(i)
+}
+
+// ListAllWAFRules calls Client.ListAllWAFRules.
+func (w *WAFClient) ListAllWAFRules(i *ListAllWAFRulesInput) (*WAFRuleResponse, error) {
+	return w.client.ListAllWAFRules(i)
+}
+
+// StreamWAFRules calls Client.StreamWAFRules.
+func (w *WAFClient) StreamWAFRules(i *ListAllWAFRulesInput, fn func(*WAFRule) error) error {
+	return w.client.StreamWAFRules(i, fn)
+}
+
+// WAFRuleCatalog calls Client.WAFRuleCatalog.
+func (w *WAFClient) WAFRuleCatalog(i *ListAllWAFRulesInput) (*WAFRuleCatalog, error) {
+	return w.client.WAFRuleCatalog(i)
+}
+
+// ListWAFRuleRevisions calls Client.ListWAFRuleRevisions.
+func (w *WAFClient) ListWAFRuleRevisions(i *ListWAFRuleRevisionsInput) (*WAFRuleRevisionResponse, error) {
+	return w.client.ListWAFRuleRevisions(i)
+}
+
+// ListAllWAFRuleRevisions calls Client.ListAllWAFRuleRevisions.
+func (w *WAFClient) ListAllWAFRuleRevisions(i *ListAllWAFRuleRevisionsInput) (*WAFRuleRevisionResponse, error) {
+	return w.client.ListAllWAFRuleRevisions(i)
+}
+
+// GetWAFRuleRevision calls Client.GetWAFRuleRevision.
+func (w *WAFClient) GetWAFRuleRevision(i *GetWAFRuleRevisionInput) (*WAFRuleRevision, error) {
+	return w.client.GetWAFRuleRevision(i)
+}
+
+// UpdateWAFRuleSets calls Client.UpdateWAFRuleSets.
+func (w *WAFClient) UpdateWAFRuleSets(i *UpdateWAFRuleSetsInput) (*WAFUpdateStatus, error) {
+	return w.client.UpdateWAFRuleSets(i)
+}
+
+// GetWAFUpdateStatus calls Client.GetWAFUpdateStatus.
+func (w *WAFClient) GetWAFUpdateStatus(i *GetWAFUpdateStatusInput) (*WAFUpdateStatus, error) {
+	return w.client.GetWAFUpdateStatus(i)
+}
+
+// ListConfigurationSets calls Client.ListConfigurationSets.
+func (w *WAFClient) ListConfigurationSets(i *ListConfigurationSetsInput) (*WAFConfigurationSetResponse, error) {
+	return w.client.ListConfigurationSets(i)
+}
+
+// UpdateWAFConfigurationSet calls Client.UpdateWAFConfigurationSet.
+func (w *WAFClient) UpdateWAFConfigurationSet(i *UpdateWAFConfigurationSetInput) ([]*ConfigurationSetWAF, error) {
+	return w.client.UpdateWAFConfigurationSet(i)
+}
+
+// TLSClient groups the methods of the TLS APIs: custom and platform certificates, private keys, configurations, domains, activations and subscriptions. It is returned by Client.TLS.
+type TLSClient struct {
+	client *Client
+}
+
+// TLS returns a TLSClient that sends its requests with c.
+func (c *Client) TLS() *TLSClient {
+	return &TLSClient{client: c}
+}
+
+// ListCustomTLSCertificates calls Client.ListCustomTLSCertificates.
+func (t *TLSClient) ListCustomTLSCertificates(i *ListCustomTLSCertificatesInput) ([]*CustomTLSCertificate, error) {
+	return t.client.ListCustomTLSCertificates(i)
+}
+
+// GetCustomTLSCertificate calls Client.GetCustomTLSCertificate.
+func (t *TLSClient) GetCustomTLSCertificate(i *GetCustomTLSCertificateInput) (*CustomTLSCertificate, error) {
+	return t.client.GetCustomTLSCertificate(i)
+}
+
+// CreateCustomTLSCertificate calls Client.CreateCustomTLSCertificate.
+func (t *TLSClient) CreateCustomTLSCertificate(i *CreateCustomTLSCertificateInput) (*CustomTLSCertificate, error) {
+	return t.client.CreateCustomTLSCertificate(i)
+}
+
+// UpdateCustomTLSCertificate calls Client.UpdateCustomTLSCertificate.
+func (t *TLSClient) UpdateCustomTLSCertificate(i *UpdateCustomTLSCertificateInput) (*CustomTLSCertificate, error) {
+	return t.client.UpdateCustomTLSCertificate(i)
+}
+
+// DeleteCustomTLSCertificate calls Client.DeleteCustomTLSCertificate.
+func (t *TLSClient) DeleteCustomTLSCertificate(i *DeleteCustomTLSCertificateInput) error {
+	return t.client.DeleteCustomTLSCertificate(i)
+}
+
+// ListCustomTLSConfigurations calls Client.ListCustomTLSConfigurations.
+func (t *TLSClient) ListCustomTLSConfigurations(i *ListCustomTLSConfigurationsInput) ([]*CustomTLSConfiguration, error) {
+	return t.client.ListCustomTLSConfigurations(i)
+}
+
+// GetCustomTLSConfiguration calls Client.GetCustomTLSConfiguration.
+func (t *TLSClient) GetCustomTLSConfiguration(i *GetCustomTLSConfigurationInput) (*CustomTLSConfiguration, error) {
+	return t.client.GetCustomTLSConfiguration(i)
+}
+
+// UpdateCustomTLSConfiguration calls Client.UpdateCustomTLSConfiguration.
+func (t *TLSClient) UpdateCustomTLSConfiguration(i *UpdateCustomTLSConfigurationInput) (*CustomTLSConfiguration, error) {
+	return t.client.UpdateCustomTLSConfiguration(i)
+}
+
+// ListTLSActivations calls Client.ListTLSActivations.
+func (t *TLSClient) ListTLSActivations(i *ListTLSActivationsInput) ([]*TLSActivation, error) {
+	return t.client.ListTLSActivations(i)
+}
+
+// GetTLSActivation calls Client.GetTLSActivation.
+func (t *TLSClient) GetTLSActivation(i *GetTLSActivationInput) (*TLSActivation, error) {
+	return t.client.GetTLSActivation(i)
+}
+
+// CreateTLSActivation calls Client.CreateTLSActivation.
+func (t *TLSClient) CreateTLSActivation(i *CreateTLSActivationInput) (*TLSActivation, error) {
+	return t.client.CreateTLSActivation(i)
+}
+
+// UpdateTLSActivation calls Client.UpdateTLSActivation.
+func (t *TLSClient) UpdateTLSActivation(i *UpdateTLSActivationInput) (*TLSActivation, error) {
+	return t.client.UpdateTLSActivation(i)
+}
+
+// DeleteTLSActivation calls Client.DeleteTLSActivation.
+func (t *TLSClient) DeleteTLSActivation(i *DeleteTLSActivationInput) error {
+	return t.client.DeleteTLSActivation(i)
+}
+
+// ListTLSDomains calls Client.ListTLSDomains.
+func (t *TLSClient) ListTLSDomains(i *ListTLSDomainsInput) ([]*TLSDomain, error) {
+	return t.client.ListTLSDomains(i)
+}
+
+// ListPrivateKeys calls Client.ListPrivateKeys.
+func (t *TLSClient) ListPrivateKeys(i *ListPrivateKeysInput) ([]*PrivateKey, error) {
+	return t.client.ListPrivateKeys(i)
+}
+
+// GetPrivateKey calls Client.GetPrivateKey.
+func (t *TLSClient) GetPrivateKey(i *GetPrivateKeyInput) (*PrivateKey, error) {
+	return t.client.GetPrivateKey(i)
+}
+
+// CreatePrivateKey calls Client.CreatePrivateKey.
+func (t *TLSClient) CreatePrivateKey(i *CreatePrivateKeyInput) (*PrivateKey, error) {
+	return t.client.CreatePrivateKey(i)
+}
+
+// DeletePrivateKey calls Client.DeletePrivateKey.
+func (t *TLSClient) DeletePrivateKey(i *DeletePrivateKeyInput) error {
+	return t.client.DeletePrivateKey(i)
+}
+
+// ListBulkCertificates calls Client.ListBulkCertificates.
+func (t *TLSClient) ListBulkCertificates(i *ListBulkCertificatesInput) ([]*BulkCertificate, error) {
+	return t.client.ListBulkCertificates(i)
+}
+
+// GetBulkCertificate calls Client.GetBulkCertificate.
+func (t *TLSClient) GetBulkCertificate(i *GetBulkCertificateInput) (*BulkCertificate, error) {
+	return t.client.GetBulkCertificate(i)
+}
+
+// CreateBulkCertificate calls Client.CreateBulkCertificate.
+func (t *TLSClient) CreateBulkCertificate(i *CreateBulkCertificateInput) (*BulkCertificate, error) {
+	return t.client.CreateBulkCertificate(i)
+}
+
+// UpdateBulkCertificate calls Client.UpdateBulkCertificate.
+func (t *TLSClient) UpdateBulkCertificate(i *UpdateBulkCertificateInput) (*BulkCertificate, error) {
+	return t.client.UpdateBulkCertificate(i)
+}
+
+// DeleteBulkCertificate calls Client.DeleteBulkCertificate.
+func (t *TLSClient) DeleteBulkCertificate(i *DeleteBulkCertificateInput) error {
+	return t.client.DeleteBulkCertificate(i)
+}
+
+// ListTLSSubscriptions calls Client.ListTLSSubscriptions.
+func (t *TLSClient) ListTLSSubscriptions(i *ListTLSSubscriptionsInput) ([]*TLSSubscription, error) {
+	return t.client.ListTLSSubscriptions(i)
+}
+
+// CreateTLSSubscription calls Client.CreateTLSSubscription.
+func (t *TLSClient) CreateTLSSubscription(i *CreateTLSSubscriptionInput) (*TLSSubscription, error) {
+	return t.client.CreateTLSSubscription(i)
+}
+
+// GetTLSSubscription calls Client.GetTLSSubscription.
+func (t *TLSClient) GetTLSSubscription(i *GetTLSSubscriptionInput) (*TLSSubscription, error) {
+	return t.client.GetTLSSubscription(i)
+}
+
+// UpdateTLSSubscription calls Client.UpdateTLSSubscription.
+func (t *TLSClient) UpdateTLSSubscription(i *UpdateTLSSubscriptionInput) (*TLSSubscription, error) {
+	return t.client.UpdateTLSSubscription(i)
+}
+
+// DeleteTLSSubscription calls Client.DeleteTLSSubscription.
+func (t *TLSClient) DeleteTLSSubscription(i *DeleteTLSSubscriptionInput) error {
+	return t.client.DeleteTLSSubscription(i)
+}
+
+// StatsClient groups the methods of the historical stats and usage API. It is returned by Client.Stats.
+type StatsClient struct {
+	client *Client
+}
+
+// Stats returns a StatsClient that sends its requests with c.
+func (c *Client) Stats() *StatsClient {
+	return &StatsClient{client: c}
+}
+
+// GetStats calls Client.GetStats.
+func (s *StatsClient) GetStats(i *GetStatsInput) (*StatsResponse, error) {
+	return s.client.GetStats(i)
+}
+
+// GetStatsField calls Client.GetStatsField.
+func (s *StatsClient) GetStatsField(i *GetStatsInput) (*StatsFieldResponse, error) {
+	return s.client.GetStatsField(i)
+}
+
+// GetStatsFieldSeries calls Client.GetStatsFieldSeries.
+func (s *StatsClient) GetStatsFieldSeries(i *GetStatsInput) ([]*StatsSeries, error) {
+	return s.client.GetStatsFieldSeries(i)
+}
+
+// GetStatsJSON calls Client.GetStatsJSON.
+func (s *StatsClient) GetStatsJSON(i *GetStatsInput, dst interface{}) error {
+	return s.client.GetStatsJSON(i, dst)
+}
+
+// GetUsage calls Client.GetUsage.
+func (s *StatsClient) GetUsage(i *GetUsageInput) (*UsageResponse, error) {
+	return s.client.GetUsage(i)
+}
+
+// GetUsageByService calls Client.GetUsageByService.
+func (s *StatsClient) GetUsageByService(i *GetUsageInput) (*UsageByServiceResponse, error) {
+	return s.client.GetUsageByService(i)
+}
+
+// GetUsageByMonth calls Client.GetUsageByMonth.
+func (s *StatsClient) GetUsageByMonth(i *GetUsageByMonthInput) (*UsageByMonthResponse, error) {
+	return s.client.GetUsageByMonth(i)
+}
+
+// GetRegions calls Client.GetRegions.
+func (s *StatsClient) GetRegions() (*RegionsResponse, error) {
+	return s.client.GetRegions()
+}
@@ -0,0 +1,86 @@
+package fastly
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_scopedClients(t *testing.T) {
+	t.Parallel()
+
+	client := reflect.TypeOf(&Client{})
+	for _, tc := range []struct {
+		scoped reflect.Type
+		area   func(name string) bool
+	}{
+		{reflect.TypeOf(&WAFClient{}), func(name string) bool {
+			return strings.Contains(name, "WAF")
+		}},
+		{reflect.TypeOf(&TLSClient{}), func(name string) bool {
+			return strings.Contains(name, "TLS") || strings.HasSuffix(name, "BulkCertificate") || strings.HasSuffix(name, "PrivateKey")
+		}},
+		{reflect.TypeOf(&StatsClient{}), func(name string) bool {
+			return strings.HasPrefix(name, "GetStats") || strings.HasPrefix(name, "GetUsage")
+		}},
+	} {
+		// Every method must match the Client method of the same name.
+		for i := 0; i < tc.scoped.NumMethod(); i++ {
+			m := tc.scoped.Method(i)
+			cm, ok := client.MethodByName(m.Name)
+			if !ok {
+				t.Errorf("%s.%s has no Client counterpart", tc.scoped.Elem().Name(), m.Name)
+				continue
+			}
+			if !sameSignature(m.Type, cm.Type) {
+				t.Errorf("%s.%s: signature %s differs from Client's %s", tc.scoped.Elem().Name(), m.Name, m.Type, cm.Type)
+			}
+		}
+
+		// Every Client method of the area must be available.
+		for i := 0; i < client.NumMethod(); i++ {
+			name := client.Method(i).Name
+			if !tc.area(name) || name+"Client" == tc.scoped.Elem().Name() {
+				continue
+			}
+			if _, ok := tc.scoped.MethodByName(name); !ok {
+				t.Errorf("%s is missing Client.%s", tc.scoped.Elem().Name(), name)
+			}
+		}
+	}
+}
+
+// sameSignature reports whether the method types a and b, whose first
+// argument is the receiver, have the same arguments and results.
+func sameSignature(a, b reflect.Type) bool {
+	if a.NumIn() != b.NumIn() || a.NumOut() != b.NumOut() {
+		return false
+	}
+	for i := 1; i < a.NumIn(); i++ {
+		if a.In(i) != b.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < a.NumOut(); i++ {
+		if a.Out(i) != b.Out(i) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestStatsClient_GetRegions(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var regions *RegionsResponse
+	record(t, "stats/regions", func(c *Client) {
+		regions, err = c.Stats().GetRegions()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions.Data) == 0 {
+		t.Error("expected regions")
+	}
+}
@@ -0,0 +1,156 @@
+package fastly
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+// SigningKey is the public key used to sign the client keys of the Secret
+// Store API.
+type SigningKey struct {
+	Key ed25519.PublicKey `json:"signing_key"`
+}
+
+// GetSigningKey returns the public key that client keys are signed with.
+func (c *Client) GetSigningKey() (*SigningKey, error) {
+	resp, err := c.Get("/resources/stores/secret/signing-key", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var sk *SigningKey
+	if err := json.NewDecoder(resp.Body).Decode(&sk); err != nil {
+		return nil, err
+	}
+	if len(sk.Key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid signing key length %d", len(sk.Key))
+	}
+	return sk, nil
+}
+
+// ClientKey is a short lived public key used to seal secrets before they are
+// sent to the Secret Store API.
+type ClientKey struct {
+	PublicKey []byte    `json:"client_key"`
+	Signature []byte    `json:"signature"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateClientKey creates a new client key.
+func (c *Client) CreateClientKey() (*ClientKey, error) {
+	resp, err := c.Post("/resources/stores/secret/client-key", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var ck *ClientKey
+	if err := json.NewDecoder(resp.Body).Decode(&ck); err != nil {
+		return nil, err
+	}
+	return ck, nil
+}
+
+// VerifySignature reports whether the client key was signed by the given
+// signing key.
+func (ck *ClientKey) VerifySignature(sk *SigningKey) bool {
+	return len(sk.Key) == ed25519.PublicKeySize && ed25519.Verify(sk.Key, ck.PublicKey, ck.Signature)
+}
+
+// Seal encrypts plaintext to the client key with an anonymous libsodium
+// sealed box, which only the Secret Store API can open.
+func (ck *ClientKey) Seal(plaintext []byte) ([]byte, error) {
+	var pub [32]byte
+	if len(ck.PublicKey) != len(pub) {
+		return nil, fmt.Errorf("invalid client key length %d", len(ck.PublicKey))
+	}
+	copy(pub[:], ck.PublicKey)
+	return box.SealAnonymous(nil, plaintext, &pub, rand.Reader)
+}
+
+// Secret is a secret of a Secret Store. The value of a secret is never
+// returned by the API, only its digest.
+type Secret struct {
+	Name      string    `json:"name"`
+	Digest    []byte    `json:"digest"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateSealedSecretInput is the input to the CreateSealedSecret function.
+type CreateSealedSecretInput struct {
+	// StoreID is the ID of the Secret Store (required).
+	StoreID string
+	// Name is the name of the secret (required).
+	Name string
+	// Secret is the plaintext value of the secret (required). It is sealed
+	// before it leaves the client.
+	Secret []byte
+}
+
+// createSecretPayload is the body sent to create a wrapped secret.
+type createSecretPayload struct {
+	Name      string `json:"name"`
+	ClientKey []byte `json:"client_key"`
+	Secret    []byte `json:"secret"`
+}
+
+// CreateSealedSecret creates a secret without sending its plaintext value.
+// A new client key is created and checked against the API's signing key, the
+// secret is sealed to it locally, and the sealed value is submitted along
+// with the client key.
+func (c *Client) CreateSealedSecret(i *CreateSealedSecretInput) (*Secret, error) {
+	if i.StoreID == "" {
+		return nil, ErrMissingStoreID
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if len(i.Secret) == 0 {
+		return nil, ErrMissingSecret
+	}
+
+	sk, err := c.GetSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	ck, err := c.CreateClientKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ck.VerifySignature(sk) {
+		return nil, ErrInvalidClientKeySignature
+	}
+
+	sealed, err := ck.Seal(i.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/resources/stores/secret/%s/secrets", url.PathEscape(i.StoreID))
+	resp, err := c.PostJSON(path, &createSecretPayload{
+		Name:      i.Name,
+		ClientKey: ck.PublicKey,
+		Secret:    sealed,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var s *Secret
+	if err := json.NewDecoder(resp.Body).Decode(&s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,114 @@
+package fastly
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestClient_CreateSealedSecret(t *testing.T) {
+	t.Parallel()
+
+	signPub, signPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	boxPub, boxPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := ed25519.Sign(signPriv, boxPub[:])
+
+	var opened string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/resources/stores/secret/signing-key":
+			json.NewEncoder(w).Encode(map[string][]byte{"signing_key": signPub})
+		case r.Method == http.MethodPost && r.URL.Path == "/resources/stores/secret/client-key":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"client_key": boxPub[:],
+				"signature":  signature,
+				"expires_at": "2022-06-01T12:00:00Z",
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/resources/stores/secret/store-id/secrets":
+			var p createSecretPayload
+			if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			plaintext, ok := box.OpenAnonymous(nil, p.Secret, boxPub, boxPriv)
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			opened = string(plaintext)
+			fmt.Fprintf(w, `{"name":%q,"digest":"ZGlnZXN0","created_at":"2022-06-01T11:00:00Z"}`, p.Name)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := c.CreateSealedSecret(&CreateSealedSecretInput{
+		StoreID: "store-id",
+		Name:    "api-token",
+		Secret:  []byte("hunter2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened != "hunter2" {
+		t.Errorf("bad opened secret: %q", opened)
+	}
+	if s.Name != "api-token" || string(s.Digest) != "digest" {
+		t.Errorf("bad secret: %+v", s)
+	}
+
+	// A client key that was not signed by the signing key must be rejected.
+	signature[0] ^= 0xff
+	_, err = c.CreateSealedSecret(&CreateSealedSecretInput{
+		StoreID: "store-id",
+		Name:    "api-token",
+		Secret:  []byte("hunter2"),
+	})
+	if err != ErrInvalidClientKeySignature {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_CreateSealedSecret_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateSealedSecret(&CreateSealedSecretInput{
+		StoreID: "",
+	})
+	if err != ErrMissingStoreID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSealedSecret(&CreateSealedSecretInput{
+		StoreID: "store-id",
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSealedSecret(&CreateSealedSecretInput{
+		StoreID: "store-id",
+		Name:    "api-token",
+	})
+	if err != ErrMissingSecret {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -240,3 +240,51 @@ func (c *Client) DeleteServer(i *DeleteServerInput) error {
 	}
 	return nil
 }
+
+// DisableServerInput is used as input to the DisableServer function.
+type DisableServerInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// PoolID is the ID of the pool (required).
+	PoolID string
+
+	// Server is the ID of the server to disable (required).
+	Server string
+}
+
+// DisableServer stops a pool from sending traffic to a server, e.g. to fail
+// over from an unhealthy origin during an incident. Servers are versionless,
+// so the change takes effect without cloning or activating a service version.
+// Use ListServers to find the ID of a server by its address.
+func (c *Client) DisableServer(i *DisableServerInput) (*Server, error) {
+	return c.UpdateServer(&UpdateServerInput{
+		ServiceID: i.ServiceID,
+		PoolID:    i.PoolID,
+		Server:    i.Server,
+		Disabled:  Bool(true),
+	})
+}
+
+// EnableServerInput is used as input to the EnableServer function.
+type EnableServerInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// PoolID is the ID of the pool (required).
+	PoolID string
+
+	// Server is the ID of the server to enable (required).
+	Server string
+}
+
+// EnableServer lets a pool send traffic to a server disabled with
+// DisableServer again, without a version bump.
+func (c *Client) EnableServer(i *EnableServerInput) (*Server, error) {
+	return c.UpdateServer(&UpdateServerInput{
+		ServiceID: i.ServiceID,
+		PoolID:    i.PoolID,
+		Server:    i.Server,
+		Disabled:  Bool(false),
+	})
+}
@@ -123,6 +123,38 @@ func TestClient_Servers(t *testing.T) {
 		t.Errorf("bad weight: %q", 50)
 	}
 
+	// Disable
+	var ds *Server
+	record(t, "servers/disable", func(c *Client) {
+		ds, err = c.DisableServer(&DisableServerInput{
+			ServiceID: testServiceID,
+			PoolID:    testPool.ID,
+			Server:    server.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ds.Disabled {
+		t.Errorf("bad disabled: %t", ds.Disabled)
+	}
+
+	// Enable
+	var es *Server
+	record(t, "servers/enable", func(c *Client) {
+		es, err = c.EnableServer(&EnableServerInput{
+			ServiceID: testServiceID,
+			PoolID:    testPool.ID,
+			Server:    server.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if es.Disabled {
+		t.Errorf("bad disabled: %t", es.Disabled)
+	}
+
 	// Delete
 	record(t, "servers/delete", func(c *Client) {
 		err = c.DeleteServer(&DeleteServerInput{
@@ -226,6 +258,33 @@ func TestClient_UpdateServer_validation(t *testing.T) {
 	}
 }
 
+func TestClient_DisableServer_validation(t *testing.T) {
+	var err error
+	_, err = testClient.DisableServer(&DisableServerInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.EnableServer(&EnableServerInput{
+		ServiceID: "foo",
+		PoolID:    "",
+	})
+	if err != ErrMissingPoolID {
+		t.Errorf("bad error: %q", err)
+	}
+
+	_, err = testClient.DisableServer(&DisableServerInput{
+		ServiceID: "foo",
+		PoolID:    "bar",
+		Server:    "",
+	})
+	if err != ErrMissingServer {
+		t.Errorf("bad error: %q", err)
+	}
+}
+
 func TestClient_DeleteServer_validation(t *testing.T) {
 	var err error
 	err = testClient.DeleteServer(&DeleteServerInput{
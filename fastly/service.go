@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/peterhellberg/link"
@@ -22,6 +23,10 @@ type Service struct {
 	DeletedAt     *time.Time `mapstructure:"deleted_at"`
 	ActiveVersion uint       `mapstructure:"version"`
 	Versions      []*Version `mapstructure:"versions"`
+
+	// ETag is the entity tag of the service when it was fetched with
+	// GetService, to pass as IfNoneMatch to later calls.
+	ETag string `mapstructure:"-"`
 }
 
 type ServiceDetail struct {
@@ -36,6 +41,10 @@ type ServiceDetail struct {
 	CreatedAt     *time.Time `mapstructure:"created_at"`
 	UpdatedAt     *time.Time `mapstructure:"updated_at"`
 	DeletedAt     *time.Time `mapstructure:"deleted_at"`
+
+	// ETag is the entity tag of the service when it was fetched with
+	// GetServiceDetails, to pass as IfNoneMatch to later calls.
+	ETag string `mapstructure:"-"`
 }
 
 type ServiceDomain struct {
@@ -62,15 +71,40 @@ func (s servicesByName) Less(i, j int) bool {
 
 // ListServicesInput is used as input to the ListServices function.
 type ListServicesInput struct {
+	// Direction is the direction in which to sort results, "ascend" or
+	// "descend".
 	Direction string
-	PerPage   int
-	Page      int
-	Sort      string
+
+	// PerPage is the number of services to return per page.
+	PerPage int
+
+	// Page is the current page.
+	Page int
+
+	// Sort is the field on which to sort, e.g. "created". When empty, the
+	// services are sorted by name.
+	Sort string
 }
 
-// ListServices returns the full list of services for the current account.
+// ListServices returns the list of services for the current account. Only the
+// requested page is returned when Page or PerPage is set, use ListAllServices
+// or NewListServicesPaginator to fetch every service of a large account.
 func (c *Client) ListServices(i *ListServicesInput) ([]*Service, error) {
-	resp, err := c.Get("/service", nil)
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Direction != "" {
+		ro.Params["direction"] = i.Direction
+	}
+	if i.Page > 0 {
+		ro.Params["page"] = strconv.Itoa(i.Page)
+	}
+	if i.PerPage > 0 {
+		ro.Params["per_page"] = strconv.Itoa(i.PerPage)
+	}
+	if i.Sort != "" {
+		ro.Params["sort"] = i.Sort
+	}
+
+	resp, err := c.Get("/service", ro)
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +113,28 @@ func (c *Client) ListServices(i *ListServicesInput) ([]*Service, error) {
 	if err := decodeBodyMap(resp.Body, &s); err != nil {
 		return nil, err
 	}
-	sort.Stable(servicesByName(s))
+	if i.Sort == "" {
+		sort.Stable(servicesByName(s))
+	}
 	return s, nil
 }
 
+// ListAllServices returns every service of the current account, following
+// the pages of the listing until the last one. Page is the first page to
+// fetch.
+func (c *Client) ListAllServices(i *ListServicesInput) ([]*Service, error) {
+	var ss []*Service
+	p := c.NewListServicesPaginator(i)
+	for p.HasNext() {
+		s, err := p.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s...)
+	}
+	return ss, nil
+}
+
 type ListServicesPaginator struct {
 	consumed    bool
 	CurrentPage int
@@ -181,7 +233,9 @@ func (c *Client) listServicesWithPage(i *ListServicesInput, p *ListServicesPagin
 		return nil, err
 	}
 
-	sort.Stable(servicesByName(s))
+	if i.Sort == "" {
+		sort.Stable(servicesByName(s))
+	}
 
 	return s, nil
 }
@@ -207,9 +261,122 @@ func (c *Client) CreateService(i *CreateServiceInput) (*Service, error) {
 	return s, nil
 }
 
+// CreateServiceWithDefaultsInput is used as input to the
+// CreateServiceWithDefaults function.
+type CreateServiceWithDefaultsInput struct {
+	// Name is the name of the service (required).
+	Name string
+
+	// Domain is the domain name that the service will respond to (required).
+	Domain string
+
+	// BackendAddress is the hostname or IP address of the origin (required).
+	BackendAddress string
+
+	// Comment is a personal, freeform descriptive note for the service.
+	Comment string
+
+	// Activate activates the first version of the service once it has been
+	// validated.
+	Activate bool
+}
+
+// CreateServiceWithDefaults creates a service that serves Domain from the
+// origin at BackendAddress, in a single call. The domain and a backend named
+// after its address are added to version 1 of the new service, which is then
+// validated and, if Activate is set, activated. The backend connects to the
+// origin over TLS on port 443, checking its certificate against
+// BackendAddress.
+//
+// If any step fails, the service is deleted again and the original error
+// returned, so no half-configured service is left behind.
+func (c *Client) CreateServiceWithDefaults(i *CreateServiceWithDefaultsInput) (*Service, error) {
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.Domain == "" {
+		return nil, ErrMissingDomain
+	}
+
+	if i.BackendAddress == "" {
+		return nil, ErrMissingBackendAddress
+	}
+
+	s, err := c.CreateService(&CreateServiceInput{
+		Name:    i.Name,
+		Comment: i.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.configureDefaultVersion(s, i); err != nil {
+		if derr := c.DeleteService(&DeleteServiceInput{ID: s.ID}); derr != nil {
+			return nil, fmt.Errorf("%w (deleting service %s also failed: %v)", err, s.ID, derr)
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// configureDefaultVersion adds the domain and backend of i to version 1 of
+// the newly created service s, validates it and optionally activates it.
+func (c *Client) configureDefaultVersion(s *Service, i *CreateServiceWithDefaultsInput) error {
+	const version = 1
+
+	if _, err := c.CreateDomain(&CreateDomainInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+		Name:           i.Domain,
+	}); err != nil {
+		return err
+	}
+
+	if _, err := c.CreateBackend(&CreateBackendInput{
+		ServiceID:       s.ID,
+		ServiceVersion:  version,
+		Name:            i.BackendAddress,
+		Address:         i.BackendAddress,
+		Port:            Uint(443),
+		UseSSL:          Bool(true),
+		SSLCertHostname: i.BackendAddress,
+		SSLSNIHostname:  i.BackendAddress,
+	}); err != nil {
+		return err
+	}
+
+	ok, msg, err := c.ValidateVersion(&ValidateVersionInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("version %d failed validation: %s", version, msg)
+	}
+
+	if i.Activate {
+		if _, err := c.ActivateVersion(&ActivateVersionInput{
+			ServiceID:      s.ID,
+			ServiceVersion: version,
+		}); err != nil {
+			return err
+		}
+		s.ActiveVersion = version
+	}
+	return nil
+}
+
 // GetServiceInput is used as input to the GetService function.
 type GetServiceInput struct {
 	ID string
+
+	// IfNoneMatch, if set, is the ETag of a previously fetched service. When
+	// it is unchanged, the call fails with an *HTTPError for which
+	// IsNotModified is true instead of returning the service again.
+	IfNoneMatch string
 }
 
 // GetService retrieves the service information for the service with the given
@@ -221,7 +388,7 @@ func (c *Client) GetService(i *GetServiceInput) (*Service, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s", i.ID)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{IfNoneMatch: i.IfNoneMatch})
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +397,7 @@ func (c *Client) GetService(i *GetServiceInput) (*Service, error) {
 	if err := decodeBodyMap(resp.Body, &s); err != nil {
 		return nil, err
 	}
+	s.ETag = resp.Header.Get("ETag")
 
 	// NOTE: GET /service/:service_id endpoint does not return the "version" field
 	// unlike other GET service endpoints (/service, /service/:service_id/details).
@@ -254,7 +422,7 @@ func (c *Client) GetServiceDetails(i *GetServiceInput) (*ServiceDetail, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/details", i.ID)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{IfNoneMatch: i.IfNoneMatch})
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +431,7 @@ func (c *Client) GetServiceDetails(i *GetServiceInput) (*ServiceDetail, error) {
 	if err := decodeBodyMap(resp.Body, &s); err != nil {
 		return nil, err
 	}
+	s.ETag = resp.Header.Get("ETag")
 
 	return s, nil
 }
@@ -329,6 +498,102 @@ func (c *Client) DeleteService(i *DeleteServiceInput) error {
 	return nil
 }
 
+// SafeDeleteServiceInput is used as input to the SafeDeleteService function.
+type SafeDeleteServiceInput struct {
+	ID string
+
+	// CheckTLSActivations refuses to delete the service while TLS
+	// certificates are activated for any domain of its active version, since
+	// those domains would stop serving traffic over TLS.
+	CheckTLSActivations bool
+}
+
+// ServiceDeletionBlockedError is returned by SafeDeleteService when a check
+// of the input finds something that blocks the deletion of the service. The
+// service is left unchanged.
+type ServiceDeletionBlockedError struct {
+	// ServiceID is the ID of the service.
+	ServiceID string
+
+	// ActiveVersion is the number of the active version of the service.
+	ActiveVersion int
+
+	// TLSActivations are the TLS activations of the domains of the active
+	// version.
+	TLSActivations []*TLSActivation
+}
+
+// Error implements the error interface.
+func (e *ServiceDeletionBlockedError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "service %s cannot be deleted: domains of active version %d have TLS activations", e.ServiceID, e.ActiveVersion)
+	for _, a := range e.TLSActivations {
+		domain := ""
+		if a.Domain != nil {
+			domain = a.Domain.ID
+		}
+		fmt.Fprintf(&b, "\n  activation %s for domain %s", a.ID, domain)
+	}
+	return b.String()
+}
+
+// SafeDeleteService deletes a service that may still be serving traffic. The
+// API only deletes services without an active version, so the active version,
+// if any, is deactivated first. When CheckTLSActivations is set and the
+// domains of the active version have TLS activations, a
+// *ServiceDeletionBlockedError is returned instead, before anything is
+// changed.
+func (c *Client) SafeDeleteService(i *SafeDeleteServiceInput) error {
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	s, err := c.GetService(&GetServiceInput{ID: i.ID})
+	if err != nil {
+		return err
+	}
+
+	if s.ActiveVersion != 0 {
+		version := int(s.ActiveVersion)
+
+		if i.CheckTLSActivations {
+			ds, err := c.ListDomains(&ListDomainsInput{
+				ServiceID:      i.ID,
+				ServiceVersion: version,
+			})
+			if err != nil {
+				return err
+			}
+			var activations []*TLSActivation
+			for _, d := range ds {
+				as, err := c.ListTLSActivations(&ListTLSActivationsInput{
+					FilterTLSDomainID: d.Name,
+				})
+				if err != nil {
+					return err
+				}
+				activations = append(activations, as...)
+			}
+			if len(activations) > 0 {
+				return &ServiceDeletionBlockedError{
+					ServiceID:      i.ID,
+					ActiveVersion:  version,
+					TLSActivations: activations,
+				}
+			}
+		}
+
+		if _, err := c.DeactivateVersion(&DeactivateVersionInput{
+			ServiceID:      i.ID,
+			ServiceVersion: version,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return c.DeleteService(&DeleteServiceInput{ID: i.ID})
+}
+
 // SearchServiceInput is used as input to the SearchService function.
 type SearchServiceInput struct {
 	Name string
@@ -2,22 +2,57 @@ package fastly
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/google/jsonapi"
 )
 
+const (
+	// PermissionFull grants full access to a service.
+	PermissionFull Permission = "full"
+
+	// PermissionReadOnly grants read-only access to a service.
+	PermissionReadOnly Permission = "read_only"
+
+	// PermissionPurgeSelect allows purging a service by URL or surrogate key.
+	PermissionPurgeSelect Permission = "purge_select"
+
+	// PermissionPurgeAll allows purging a service entirely.
+	PermissionPurgeAll Permission = "purge_all"
+)
+
+// Permission is the level of permissions granted to a user for a service.
+type Permission string
+
+// valid reports whether p is a known permission.
+func (p Permission) valid() bool {
+	switch p {
+	case PermissionFull, PermissionReadOnly, PermissionPurgeSelect, PermissionPurgeAll:
+		return true
+	}
+	return false
+}
+
+// SAUser is the user a service authorization applies to. Login and Name are
+// only populated when the user is requested with Include.
 type SAUser struct {
-	ID string `jsonapi:"primary,user"`
+	ID    string `jsonapi:"primary,user"`
+	Login string `jsonapi:"attr,login,omitempty"`
+	Name  string `jsonapi:"attr,name,omitempty"`
 }
 
+// SAService is the service a service authorization applies to. Name is only
+// populated when the service is requested with Include.
 type SAService struct {
-	ID string `jsonapi:"primary,service"`
+	ID   string `jsonapi:"primary,service"`
+	Name string `jsonapi:"attr,name,omitempty"`
 }
 
 type ServiceAuthorization struct {
 	ID         string     `jsonapi:"primary,service_authorization"`
-	Permission string     `jsonapi:"attr,permission,omitempty"`
+	Permission Permission `jsonapi:"attr,permission,omitempty"`
 	CreatedAt  *time.Time `jsonapi:"attr,created_at,iso8601"`
 	UpdatedAt  *time.Time `jsonapi:"attr,updated_at,iso8601"`
 	DeltedAt   *time.Time `jsonapi:"attr,deleted_at,iso8601"`
@@ -25,10 +60,85 @@ type ServiceAuthorization struct {
 	Service    *SAService `jsonapi:"relation,service,omitempty"`
 }
 
+// SAResponse is an object containing the list of service authorization
+// results.
+type SAResponse struct {
+	Items []*ServiceAuthorization
+	Info  infoResponse
+}
+
+// ListServiceAuthorizationsInput is used as input to the ListServiceAuthorizations function.
+type ListServiceAuthorizationsInput struct {
+	// Limit the number of returned service authorizations.
+	PageSize int
+	// Request a specific page of service authorizations.
+	PageNumber int
+	// Limit the returned service authorizations to a specific service.
+	FilterServiceID string
+	// Limit the returned service authorizations to a specific user.
+	FilterUserID string
+	// Include related objects. Optional, comma-separated values. Permitted values: user, service.
+	Include string
+}
+
+func (i *ListServiceAuthorizationsInput) formatFilters() map[string]string {
+
+	result := map[string]string{}
+	pairings := map[string]interface{}{
+		"page[size]":         i.PageSize,
+		"page[number]":       i.PageNumber,
+		"filter[service.id]": i.FilterServiceID,
+		"filter[user.id]":    i.FilterUserID,
+		"include":            i.Include,
+	}
+
+	for key, value := range pairings {
+		switch t := reflect.TypeOf(value).String(); t {
+		case "string":
+			if value != "" {
+				result[key] = value.(string)
+			}
+		case "int":
+			if value != 0 {
+				result[key] = strconv.Itoa(value.(int))
+			}
+		}
+	}
+	return result
+}
+
+// ListServiceAuthorizations returns the list of service authorizations.
+func (c *Client) ListServiceAuthorizations(i *ListServiceAuthorizationsInput) (*SAResponse, error) {
+
+	resp, err := c.Get("/service-authorizations", &RequestOptions{
+		Params: i.formatFilters(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, body, err := getResponseInfo(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	sas, err := decodeMany[ServiceAuthorization](body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SAResponse{
+		Items: sas,
+		Info:  info,
+	}, nil
+}
+
 // GetServiceAuthorizationInput is used as input to the GetServiceAuthorization function.
 type GetServiceAuthorizationInput struct {
 	// ID of the service authorization to retrieve.
 	ID string
+	// Include related objects. Optional, comma-separated values. Permitted values: user, service.
+	Include string
 }
 
 // GetServiceAuthorization retrieves an existing service authorization using its ID.
@@ -37,8 +147,13 @@ func (c *Client) GetServiceAuthorization(i *GetServiceAuthorizationInput) (*Serv
 		return nil, ErrMissingID
 	}
 
+	ro := &RequestOptions{}
+	if i.Include != "" {
+		ro.Params = map[string]string{"include": i.Include}
+	}
+
 	path := fmt.Sprintf("/service-authorizations/%s", i.ID)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +172,7 @@ type CreateServiceAuthorizationInput struct {
 	ID string `jsonapi:"primary,service_authorization"`
 
 	// Permission is the level of permissions to grant the user to the service. Valid values are "full", "read_only", "purge_select" or "purge_all".
-	Permission string `jsonapi:"attr,permission,omitempty"`
+	Permission Permission `jsonapi:"attr,permission,omitempty"`
 
 	// ServiceID is the ID of the service to grant permissions for.
 	Service *SAService `jsonapi:"relation,service,omitempty"`
@@ -74,6 +189,9 @@ func (c *Client) CreateServiceAuthorization(i *CreateServiceAuthorizationInput)
 	if i.User == nil || i.User.ID == "" {
 		return nil, ErrMissingServiceAuthorizationsUser
 	}
+	if i.Permission != "" && !i.Permission.valid() {
+		return nil, ErrInvalidPermission
+	}
 
 	resp, err := c.PostJSONAPI("/service-authorizations", i, nil)
 	if err != nil {
@@ -94,7 +212,7 @@ type UpdateServiceAuthorizationInput struct {
 	ID string `jsonapi:"primary,service_authorization"`
 
 	// The permission to grant the user to the service referenced by this service authorization.
-	Permissions string `jsonapi:"attr,permission,omitempty"`
+	Permissions Permission `jsonapi:"attr,permission,omitempty"`
 }
 
 // UpdateServiceAuthorization updates an exisitng service authorization. The ID must be known.
@@ -107,6 +225,10 @@ func (c *Client) UpdateServiceAuthorization(i *UpdateServiceAuthorizationInput)
 		return nil, ErrMissingPermissions
 	}
 
+	if !i.Permissions.valid() {
+		return nil, ErrInvalidPermission
+	}
+
 	path := fmt.Sprintf("/service-authorizations/%s", i.ID)
 	resp, err := c.PatchJSONAPI(path, i, nil)
 	if err != nil {
@@ -138,3 +260,71 @@ func (c *Client) DeleteServiceAuthorization(i *DeleteServiceAuthorizationInput)
 
 	return err
 }
+
+// BatchServiceAuthorization is a service authorization as sent to the bulk
+// endpoints.
+type BatchServiceAuthorization struct {
+	ID         string     `jsonapi:"primary,service_authorization"`
+	Permission Permission `jsonapi:"attr,permission,omitempty"`
+}
+
+// DeleteServiceAuthorizationsInput is used as input to the DeleteServiceAuthorizations function.
+type DeleteServiceAuthorizationsInput struct {
+	// IDs of the service authorizations to delete.
+	IDs []string
+}
+
+// DeleteServiceAuthorizations deletes multiple service authorizations in a
+// single request.
+func (c *Client) DeleteServiceAuthorizations(i *DeleteServiceAuthorizationsInput) error {
+	if len(i.IDs) == 0 {
+		return ErrMissingIDs
+	}
+
+	sas := make([]*BatchServiceAuthorization, len(i.IDs))
+	for n, id := range i.IDs {
+		sas[n] = &BatchServiceAuthorization{ID: id}
+	}
+
+	_, err := c.DeleteJSONAPIBulk("/service-authorizations", sas, nil)
+	return err
+}
+
+// UpdateServiceAuthorizationsInput is used as input to the UpdateServiceAuthorizations function.
+type UpdateServiceAuthorizationsInput struct {
+	// ServiceAuthorizations to update, identified by ID.
+	ServiceAuthorizations []*BatchServiceAuthorization
+}
+
+// UpdateServiceAuthorizations updates the permissions of multiple service
+// authorizations in a single request.
+func (c *Client) UpdateServiceAuthorizations(i *UpdateServiceAuthorizationsInput) ([]*ServiceAuthorization, error) {
+	if len(i.ServiceAuthorizations) == 0 {
+		return nil, ErrMissingServiceAuthorizations
+	}
+
+	for _, sa := range i.ServiceAuthorizations {
+		if sa.ID == "" {
+			return nil, ErrMissingID
+		}
+		if sa.Permission == "" {
+			return nil, ErrMissingPermissions
+		}
+		if !sa.Permission.valid() {
+			return nil, ErrInvalidPermission
+		}
+	}
+
+	resp, err := c.PatchJSONAPIBulk("/service-authorizations", i.ServiceAuthorizations, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	sas, err := decodeMany[ServiceAuthorization](resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return sas, nil
+}
@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -49,7 +50,8 @@ func TestClient_ServiceAuthorizations(t *testing.T) {
 	var nsa *ServiceAuthorization
 	record(t, fixtureBase+"get", func(c *Client) {
 		nsa, err = c.GetServiceAuthorization(&GetServiceAuthorizationInput{
-			ID: sa.ID,
+			ID:      sa.ID,
+			Include: "user,service",
 		})
 	})
 	if err != nil {
@@ -59,6 +61,33 @@ func TestClient_ServiceAuthorizations(t *testing.T) {
 	if nsa.Service.ID != testServiceID {
 		t.Errorf("bad service id: %v", nsa.Service)
 	}
+	if nsa.Service.Name != "go-fastly-test" {
+		t.Errorf("bad service name: %q", nsa.Service.Name)
+	}
+	if nsa.User.Login != "go-fastly-test@example.com" {
+		t.Errorf("bad user login: %q", nsa.User.Login)
+	}
+	if nsa.User.Name != "Go Fastly Test" {
+		t.Errorf("bad user name: %q", nsa.User.Name)
+	}
+
+	// List
+	var sas *SAResponse
+	record(t, fixtureBase+"list", func(c *Client) {
+		sas, err = c.ListServiceAuthorizations(&ListServiceAuthorizationsInput{
+			FilterServiceID: testServiceID,
+			PageSize:        10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sas.Items) != 1 || sas.Items[0].ID != sa.ID {
+		t.Errorf("bad service authorizations: %v", sas.Items)
+	}
+	if sas.Info.Meta.PerPage != 10 {
+		t.Errorf("bad per page: %d", sas.Info.Meta.PerPage)
+	}
 
 	// Update
 	var usa *ServiceAuthorization
@@ -90,6 +119,47 @@ func TestClient_ServiceAuthorizations(t *testing.T) {
 	}
 }
 
+func TestClient_DeleteServiceAuthorizations(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "service_authorizations/bulk_delete", func(c *Client) {
+		err = c.DeleteServiceAuthorizations(&DeleteServiceAuthorizationsInput{
+			IDs: []string{"3LA2qxhWzpRitVKTq9SsEU", "5Yo3XfbHBMrZOKfUVnLtQW"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_UpdateServiceAuthorizations(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var sas []*ServiceAuthorization
+	record(t, "service_authorizations/bulk_update", func(c *Client) {
+		sas, err = c.UpdateServiceAuthorizations(&UpdateServiceAuthorizationsInput{
+			ServiceAuthorizations: []*BatchServiceAuthorization{
+				{ID: "3LA2qxhWzpRitVKTq9SsEU", Permission: PermissionReadOnly},
+				{ID: "5Yo3XfbHBMrZOKfUVnLtQW", Permission: PermissionPurgeSelect},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sas) != 2 {
+		t.Fatalf("expected 2 service authorizations, got: %d", len(sas))
+	}
+	if sas[0].Permission != PermissionReadOnly {
+		t.Errorf("bad permission: %q", sas[0].Permission)
+	}
+	if sas[1].Permission != PermissionPurgeSelect {
+		t.Errorf("bad permission: %q", sas[1].Permission)
+	}
+}
+
 func TestClient_GetServiceAuthorization_validation(t *testing.T) {
 	var err error
 	_, err = testClient.GetServiceAuthorization(&GetServiceAuthorizationInput{
@@ -117,6 +187,15 @@ func TestClient_CreateServiceAuthorization_validation(t *testing.T) {
 	if err != ErrMissingServiceAuthorizationsUser {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateServiceAuthorization(&CreateServiceAuthorizationInput{
+		Service:    &SAService{ID: "my-service-id"},
+		User:       &SAUser{ID: "my-user-id"},
+		Permission: "admin",
+	})
+	if err != ErrInvalidPermission {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_UpdateServiceAuthorization_validation(t *testing.T) {
@@ -136,6 +215,14 @@ func TestClient_UpdateServiceAuthorization_validation(t *testing.T) {
 	if err != ErrMissingPermissions {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateServiceAuthorization(&UpdateServiceAuthorizationInput{
+		ID:          "my-service-authorization-id",
+		Permissions: "admin",
+	})
+	if err != ErrInvalidPermission {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteServiceAuthorization_validation(t *testing.T) {
@@ -147,3 +234,69 @@ func TestClient_DeleteServiceAuthorization_validation(t *testing.T) {
 	}
 
 }
+
+func TestClient_DeleteServiceAuthorizations_validation(t *testing.T) {
+	err := testClient.DeleteServiceAuthorizations(&DeleteServiceAuthorizationsInput{})
+	if err != ErrMissingIDs {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateServiceAuthorizations_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateServiceAuthorizations(&UpdateServiceAuthorizationsInput{})
+	if err != ErrMissingServiceAuthorizations {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateServiceAuthorizations(&UpdateServiceAuthorizationsInput{
+		ServiceAuthorizations: []*BatchServiceAuthorization{{Permission: PermissionFull}},
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateServiceAuthorizations(&UpdateServiceAuthorizationsInput{
+		ServiceAuthorizations: []*BatchServiceAuthorization{{ID: "sa-id"}},
+	})
+	if err != ErrMissingPermissions {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateServiceAuthorizations(&UpdateServiceAuthorizationsInput{
+		ServiceAuthorizations: []*BatchServiceAuthorization{{ID: "sa-id", Permission: "admin"}},
+	})
+	if err != ErrInvalidPermission {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_listServiceAuthorizations_formatFilters(t *testing.T) {
+	cases := []struct {
+		remote *ListServiceAuthorizationsInput
+		local  map[string]string
+	}{
+		{
+			remote: &ListServiceAuthorizationsInput{
+				PageSize:        2,
+				PageNumber:      2,
+				FilterServiceID: "service",
+				FilterUserID:    "user",
+				Include:         "user,service",
+			},
+			local: map[string]string{
+				"page[size]":         "2",
+				"page[number]":       "2",
+				"filter[service.id]": "service",
+				"filter[user.id]":    "user",
+				"include":            "user,service",
+			},
+		},
+	}
+	for _, c := range cases {
+		out := c.remote.formatFilters()
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\n     got: %#v", c.local, out)
+		}
+	}
+}
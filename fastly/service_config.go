@@ -0,0 +1,773 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultExportConcurrency is the default number of concurrent requests
+// issued by ExportServiceConfig.
+const DefaultExportConcurrency = 8
+
+// ServiceConfig is a snapshot of every versioned resource of a service
+// version. It can be serialized to JSON for backup and review.
+type ServiceConfig struct {
+	ServiceID      string    `json:"service_id"`
+	ServiceVersion int       `json:"service_version"`
+	Settings       *Settings `json:"settings,omitempty"`
+
+	ACLs            []*ACL            `json:"acls,omitempty"`
+	Backends        []*Backend        `json:"backends,omitempty"`
+	CacheSettings   []*CacheSetting   `json:"cache_settings,omitempty"`
+	Conditions      []*Condition      `json:"conditions,omitempty"`
+	Dictionaries    []*Dictionary     `json:"dictionaries,omitempty"`
+	Directors       []*Director       `json:"directors,omitempty"`
+	Domains         []*Domain         `json:"domains,omitempty"`
+	ERLs            []*ERL            `json:"rate_limiters,omitempty"`
+	Gzips           []*Gzip           `json:"gzips,omitempty"`
+	Headers         []*Header         `json:"headers,omitempty"`
+	HealthChecks    []*HealthCheck    `json:"healthchecks,omitempty"`
+	Pools           []*Pool           `json:"pools,omitempty"`
+	RequestSettings []*RequestSetting `json:"request_settings,omitempty"`
+	ResponseObjects []*ResponseObject `json:"response_objects,omitempty"`
+	Snippets        []*Snippet        `json:"snippets,omitempty"`
+	VCLs            []*VCL            `json:"vcls,omitempty"`
+
+	// Logging endpoints.
+	BigQueries      []*BigQuery      `json:"bigquery,omitempty"`
+	BlobStorages    []*BlobStorage   `json:"azureblob,omitempty"`
+	Cloudfiles      []*Cloudfiles    `json:"cloudfiles,omitempty"`
+	Datadogs        []*Datadog       `json:"datadog,omitempty"`
+	DigitalOceans   []*DigitalOcean  `json:"digitalocean,omitempty"`
+	Elasticsearches []*Elasticsearch `json:"elasticsearch,omitempty"`
+	FTPs            []*FTP           `json:"ftp,omitempty"`
+	GCSs            []*GCS           `json:"gcs,omitempty"`
+	Herokus         []*Heroku        `json:"heroku,omitempty"`
+	Honeycombs      []*Honeycomb     `json:"honeycomb,omitempty"`
+	HTTPS           []*HTTPS         `json:"https,omitempty"`
+	Kafkas          []*Kafka         `json:"kafka,omitempty"`
+	Kinesis         []*Kinesis       `json:"kinesis,omitempty"`
+	Logentries      []*Logentries    `json:"logentries,omitempty"`
+	Loggly          []*Loggly        `json:"loggly,omitempty"`
+	Logshuttles     []*Logshuttle    `json:"logshuttle,omitempty"`
+	NewRelics       []*NewRelic      `json:"newrelic,omitempty"`
+	Openstacks      []*Openstack     `json:"openstack,omitempty"`
+	Papertrails     []*Papertrail    `json:"papertrail,omitempty"`
+	Pubsubs         []*Pubsub        `json:"pubsub,omitempty"`
+	S3s             []*S3            `json:"s3,omitempty"`
+	Scalyrs         []*Scalyr        `json:"scalyr,omitempty"`
+	SFTPs           []*SFTP          `json:"sftp,omitempty"`
+	Splunks         []*Splunk        `json:"splunk,omitempty"`
+	Sumologics      []*Sumologic     `json:"sumologic,omitempty"`
+	Syslogs         []*Syslog        `json:"syslog,omitempty"`
+}
+
+// ExportServiceConfigInput is the input to the ExportServiceConfig function.
+type ExportServiceConfigInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// Concurrency is the maximum number of requests in flight. Defaults to
+	// DefaultExportConcurrency.
+	Concurrency int
+}
+
+// ExportServiceConfig fetches all versioned resources of a service version
+// concurrently and returns them as a single ServiceConfig. If any resource
+// fails to be fetched a *BulkError is returned.
+func (c *Client) ExportServiceConfig(i *ExportServiceConfigInput) (*ServiceConfig, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	concurrency := i.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultExportConcurrency
+	}
+
+	sc := &ServiceConfig{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	}
+	err := Bulk(context.Background(), c, sc.fetchers(c), concurrency, func(_ context.Context, fetch func() error) error {
+		return fetch()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// fetchers returns one function per resource type, each filling in the
+// corresponding field of sc. Every function writes a distinct field so they
+// are safe to run concurrently.
+func (sc *ServiceConfig) fetchers(c *Client) []func() error {
+	id, v := sc.ServiceID, sc.ServiceVersion
+	return []func() error{
+		func() (err error) {
+			sc.Settings, err = c.GetSettings(&GetSettingsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.ACLs, err = c.ListACLs(&ListACLsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Backends, err = c.ListBackends(&ListBackendsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.CacheSettings, err = c.ListCacheSettings(&ListCacheSettingsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Conditions, err = c.ListConditions(&ListConditionsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Dictionaries, err = c.ListDictionaries(&ListDictionariesInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Directors, err = c.ListDirectors(&ListDirectorsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Domains, err = c.ListDomains(&ListDomainsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.ERLs, err = c.ListERLs(&ListERLsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Gzips, err = c.ListGzips(&ListGzipsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Headers, err = c.ListHeaders(&ListHeadersInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.HealthChecks, err = c.ListHealthChecks(&ListHealthChecksInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Pools, err = c.ListPools(&ListPoolsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.RequestSettings, err = c.ListRequestSettings(&ListRequestSettingsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.ResponseObjects, err = c.ListResponseObjects(&ListResponseObjectsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Snippets, err = c.ListSnippets(&ListSnippetsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.VCLs, err = c.ListVCLs(&ListVCLsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.BigQueries, err = c.ListBigQueries(&ListBigQueriesInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.BlobStorages, err = c.ListBlobStorages(&ListBlobStoragesInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Cloudfiles, err = c.ListCloudfiles(&ListCloudfilesInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Datadogs, err = c.ListDatadog(&ListDatadogInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.DigitalOceans, err = c.ListDigitalOceans(&ListDigitalOceansInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Elasticsearches, err = c.ListElasticsearch(&ListElasticsearchInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.FTPs, err = c.ListFTPs(&ListFTPsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.GCSs, err = c.ListGCSs(&ListGCSsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Herokus, err = c.ListHerokus(&ListHerokusInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Honeycombs, err = c.ListHoneycombs(&ListHoneycombsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.HTTPS, err = c.ListHTTPS(&ListHTTPSInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Kafkas, err = c.ListKafkas(&ListKafkasInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Kinesis, err = c.ListKinesis(&ListKinesisInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Logentries, err = c.ListLogentries(&ListLogentriesInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Loggly, err = c.ListLoggly(&ListLogglyInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Logshuttles, err = c.ListLogshuttles(&ListLogshuttlesInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.NewRelics, err = c.ListNewRelic(&ListNewRelicInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Openstacks, err = c.ListOpenstack(&ListOpenstackInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Papertrails, err = c.ListPapertrails(&ListPapertrailsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Pubsubs, err = c.ListPubsubs(&ListPubsubsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.S3s, err = c.ListS3s(&ListS3sInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Scalyrs, err = c.ListScalyrs(&ListScalyrsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.SFTPs, err = c.ListSFTPs(&ListSFTPsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Splunks, err = c.ListSplunks(&ListSplunksInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Sumologics, err = c.ListSumologics(&ListSumologicsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+		func() (err error) {
+			sc.Syslogs, err = c.ListSyslogs(&ListSyslogsInput{ServiceID: id, ServiceVersion: v})
+			return
+		},
+	}
+}
+
+// ServiceConfigAction is the kind of change made to a resource.
+type ServiceConfigAction string
+
+const (
+	// ServiceConfigCreate means the resource only exists in the desired
+	// configuration.
+	ServiceConfigCreate ServiceConfigAction = "create"
+	// ServiceConfigUpdate means the resource exists in both configurations
+	// but its attributes differ.
+	ServiceConfigUpdate ServiceConfigAction = "update"
+	// ServiceConfigDelete means the resource only exists in the current
+	// configuration.
+	ServiceConfigDelete ServiceConfigAction = "delete"
+)
+
+// ServiceConfigChange is a single difference between two ServiceConfigs.
+type ServiceConfigChange struct {
+	// Action is the change needed to go from the current to the desired
+	// configuration.
+	Action ServiceConfigAction
+
+	// Resource is the API path of the resource type, e.g. "backend" or
+	// "logging/s3".
+	Resource string
+
+	// Name is the name of the resource.
+	Name string
+
+	// Fields lists the attributes that differ, for updates.
+	Fields []string
+
+	// values holds the form values sent to the API when applying the change.
+	values url.Values
+}
+
+// serviceConfigResources lists the ServiceConfig fields that are compared and
+// applied, along with the API path of each resource type. Resources are
+// matched by name. The order is the order in which resources are created, so
+// that resources are created before they are referenced; deletions happen in
+// the reverse order. Rate limiters come last as they may refer to response
+// objects, dictionaries and logging endpoints.
+//
+// Director to backend mappings are not included as they are not part of the
+// director resource.
+var serviceConfigResources = []struct {
+	field string
+	path  string
+}{
+	{"Conditions", "condition"},
+	{"HealthChecks", "healthcheck"},
+	{"ACLs", "acl"},
+	{"Dictionaries", "dictionary"},
+	{"CacheSettings", "cache_settings"},
+	{"RequestSettings", "request_settings"},
+	{"ResponseObjects", "response_object"},
+	{"Headers", "header"},
+	{"Gzips", "gzip"},
+	{"Backends", "backend"},
+	{"Directors", "director"},
+	{"Pools", "pool"},
+	{"Domains", "domain"},
+	{"Snippets", "snippet"},
+	{"VCLs", "vcl"},
+	{"BigQueries", "logging/bigquery"},
+	{"BlobStorages", "logging/azureblob"},
+	{"Cloudfiles", "logging/cloudfiles"},
+	{"Datadogs", "logging/datadog"},
+	{"DigitalOceans", "logging/digitalocean"},
+	{"Elasticsearches", "logging/elasticsearch"},
+	{"FTPs", "logging/ftp"},
+	{"GCSs", "logging/gcs"},
+	{"Herokus", "logging/heroku"},
+	{"Honeycombs", "logging/honeycomb"},
+	{"HTTPS", "logging/https"},
+	{"Kafkas", "logging/kafka"},
+	{"Kinesis", "logging/kinesis"},
+	{"Logentries", "logging/logentries"},
+	{"Loggly", "logging/loggly"},
+	{"Logshuttles", "logging/logshuttle"},
+	{"NewRelics", "logging/newrelic"},
+	{"Openstacks", "logging/openstack"},
+	{"Papertrails", "logging/papertrail"},
+	{"Pubsubs", "logging/pubsub"},
+	{"S3s", "logging/s3"},
+	{"Scalyrs", "logging/scalyr"},
+	{"SFTPs", "logging/sftp"},
+	{"Splunks", "logging/splunk"},
+	{"Sumologics", "logging/sumologic"},
+	{"Syslogs", "logging/syslog"},
+	{"ERLs", erlResource},
+}
+
+// erlResource is the API path of rate limiters. Unlike other resources, they
+// are updated and deleted by ID, outside of the version's path.
+const erlResource = "rate-limiters"
+
+// serviceConfigReadOnly lists the attributes that are never sent to the API.
+var serviceConfigReadOnly = map[string]bool{
+	"created_at":       true,
+	"deleted_at":       true,
+	"feature_revision": true,
+	"id":               true,
+	"service_id":       true,
+	"updated_at":       true,
+	"version":          true,
+}
+
+// DiffServiceConfig compares two configurations and returns the changes
+// needed to turn current into desired. Deletions are listed last.
+func DiffServiceConfig(current, desired *ServiceConfig) []*ServiceConfigChange {
+	var changes, deletes []*ServiceConfigChange
+
+	if current.Settings != nil && desired.Settings != nil {
+		cv, dv := formValues(reflect.ValueOf(current.Settings)), formValues(reflect.ValueOf(desired.Settings))
+		if fields := diffValues(cv, dv); len(fields) > 0 {
+			changes = append(changes, &ServiceConfigChange{
+				Action:   ServiceConfigUpdate,
+				Resource: "settings",
+				Fields:   fields,
+				values:   pickValues(dv, fields),
+			})
+		}
+	}
+
+	cr, dr := reflect.ValueOf(current).Elem(), reflect.ValueOf(desired).Elem()
+	for _, r := range serviceConfigResources {
+		values := formValues
+		if r.path == erlResource {
+			values = erlValues
+		}
+		cur, des := namedValues(cr.FieldByName(r.field), values), namedValues(dr.FieldByName(r.field), values)
+
+		for _, name := range sortedNames(des) {
+			dv := des[name]
+			cv, ok := cur[name]
+			if !ok {
+				changes = append(changes, &ServiceConfigChange{
+					Action:   ServiceConfigCreate,
+					Resource: r.path,
+					Name:     name,
+					values:   nonEmptyValues(dv),
+				})
+				continue
+			}
+			if fields := diffValues(cv, dv); len(fields) > 0 {
+				changes = append(changes, &ServiceConfigChange{
+					Action:   ServiceConfigUpdate,
+					Resource: r.path,
+					Name:     name,
+					Fields:   fields,
+					values:   pickValues(dv, fields),
+				})
+			}
+		}
+
+		var removed []*ServiceConfigChange
+		for _, name := range sortedNames(cur) {
+			if _, ok := des[name]; !ok {
+				removed = append(removed, &ServiceConfigChange{
+					Action:   ServiceConfigDelete,
+					Resource: r.path,
+					Name:     name,
+				})
+			}
+		}
+		deletes = append(removed, deletes...)
+	}
+
+	return append(changes, deletes...)
+}
+
+// ApplyServiceConfigInput is the input to the ApplyServiceConfig function.
+type ApplyServiceConfigInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// Config is the desired configuration (required). Its ServiceID and
+	// ServiceVersion are ignored.
+	Config *ServiceConfig
+
+	// Activate activates the new version once all changes are applied.
+	Activate bool
+}
+
+// ApplyServiceConfigResult is the result of ApplyServiceConfig.
+type ApplyServiceConfigResult struct {
+	// Version is the version the changes were applied to. When there were no
+	// changes this is the version the configuration was compared against.
+	Version *Version
+
+	// Changes lists the changes that were applied.
+	Changes []*ServiceConfigChange
+}
+
+// ApplyServiceConfig compares the desired configuration to the active
+// version of the service (or the latest version, if none is active). If they
+// differ, the version is cloned and only the resources that changed are
+// created, updated or deleted on the clone, which is then optionally
+// activated. Rate limiters are matched by name like other resources; their
+// IDs are looked up on the clone.
+func (c *Client) ApplyServiceConfig(i *ApplyServiceConfigInput) (*ApplyServiceConfigResult, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.Config == nil {
+		return nil, ErrMissingConfig
+	}
+
+	_, base, err := c.baseVersion(i.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: base.Number,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := DiffServiceConfig(current, i.Config)
+	if len(changes) == 0 {
+		return &ApplyServiceConfigResult{Version: base}, nil
+	}
+
+	v, err := c.CloneVersion(&CloneVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: base.Number,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	erlIDs, err := c.serviceConfigERLIDs(i.ServiceID, v.Number, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ch := range changes {
+		if err := c.applyServiceConfigChange(i.ServiceID, v.Number, ch, erlIDs); err != nil {
+			return nil, fmt.Errorf("error applying %s of %s %q to version %d: %w", ch.Action, ch.Resource, ch.Name, v.Number, err)
+		}
+	}
+
+	if i.Activate {
+		v, err = c.ActivateVersion(&ActivateVersionInput{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: v.Number,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ApplyServiceConfigResult{
+		Version: v,
+		Changes: changes,
+	}, nil
+}
+
+// serviceConfigERLIDs returns the IDs of the rate limiters of a version that
+// are updated or deleted by changes, keyed by name.
+func (c *Client) serviceConfigERLIDs(serviceID string, serviceVersion int, changes []*ServiceConfigChange) (map[string]string, error) {
+	needed := false
+	for _, ch := range changes {
+		if ch.Resource == erlResource && ch.Action != ServiceConfigCreate {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil, nil
+	}
+
+	erls, err := c.ListERLs(&ListERLsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(erls))
+	for _, e := range erls {
+		ids[e.Name] = e.ID
+	}
+	return ids, nil
+}
+
+// applyServiceConfigChange issues the request for a single change. erlIDs
+// maps the names of the version's rate limiters to their IDs.
+func (c *Client) applyServiceConfigChange(serviceID string, serviceVersion int, ch *ServiceConfigChange, erlIDs map[string]string) error {
+	path := fmt.Sprintf("/service/%s/version/%d/%s", serviceID, serviceVersion, ch.Resource)
+	item := path + "/" + url.PathEscape(ch.Name)
+	if ch.Resource == erlResource && ch.Action != ServiceConfigCreate {
+		id, ok := erlIDs[ch.Name]
+		if !ok {
+			return fmt.Errorf("rate limiter %q not found in version %d", ch.Name, serviceVersion)
+		}
+		item = "/rate-limiters/" + url.PathEscape(id)
+	}
+
+	var verb string
+	switch ch.Action {
+	case ServiceConfigCreate:
+		verb = "POST"
+	case ServiceConfigUpdate:
+		verb = "PUT"
+		if ch.Name != "" {
+			path = item
+		}
+	case ServiceConfigDelete:
+		resp, err := c.Delete(item, nil)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	body := ch.values.Encode()
+	resp, err := c.Request(verb, path, &RequestOptions{
+		Headers: map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		},
+		Body:       strings.NewReader(body),
+		BodyLength: int64(len(body)),
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// namedValues returns the form values of each element of a slice of
+// resources, as returned by values, keyed by the resource name.
+func namedValues(slice reflect.Value, values func(reflect.Value) url.Values) map[string]url.Values {
+	m := make(map[string]url.Values, slice.Len())
+	for n := 0; n < slice.Len(); n++ {
+		v := values(slice.Index(n))
+		m[v.Get("name")] = v
+	}
+	return m
+}
+
+// formValues returns the writable attributes of a resource, keyed by their
+// API names. Lists are encoded with brackets like the typed inputs, e.g.
+// "headers[]", and nested objects are skipped.
+func formValues(v reflect.Value) url.Values {
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	values := url.Values{}
+	t := v.Type()
+	for n := 0; n < t.NumField(); n++ {
+		key := t.Field(n).Tag.Get("mapstructure")
+		if key == "" || serviceConfigReadOnly[key] {
+			continue
+		}
+
+		f := v.Field(n)
+		if f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				continue
+			}
+			f = f.Elem()
+		}
+
+		if f.Kind() == reflect.Slice {
+			for e := 0; e < f.Len(); e++ {
+				if s, ok := formValue(f.Index(e)); ok {
+					values.Add(key+"[]", s)
+				}
+			}
+			continue
+		}
+		if s, ok := formValue(f); ok {
+			values.Set(key, s)
+		}
+	}
+	return values
+}
+
+// formValue returns the form encoding of a scalar attribute, or false for
+// values that are not scalars.
+func formValue(f reflect.Value) (string, bool) {
+	switch f.Kind() {
+	case reflect.String:
+		return f.String(), true
+	case reflect.Bool:
+		if f.Bool() {
+			return "1", true
+		}
+		return "0", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(f.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(f.Uint(), 10), true
+	}
+	return "", false
+}
+
+// erlValues returns the writable attributes of a rate limiter, including its
+// response, encoded like CreateERLInput.
+func erlValues(v reflect.Value) url.Values {
+	values := formValues(v)
+	e, ok := v.Interface().(*ERL)
+	if !ok || e == nil {
+		return values
+	}
+
+	if r := e.Response; r != nil {
+		if r.ERLStatus != 0 {
+			values.Set("response[status]", strconv.Itoa(r.ERLStatus))
+		}
+		if r.ERLContentType != "" {
+			values.Set("response[content_type]", r.ERLContentType)
+		}
+		if r.ERLContent != "" {
+			values.Set("response[content]", r.ERLContent)
+		}
+	}
+	return values
+}
+
+// diffValues returns the sorted keys whose values differ between a and b.
+func diffValues(a, b url.Values) []string {
+	var keys []string
+	for k := range b {
+		if joinValues(a[k]) != joinValues(b[k]) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// joinValues joins the values of a key, so that lists can be compared. A
+// missing key and an empty value are the same.
+func joinValues(vs []string) string {
+	return strings.Join(vs, "\x00")
+}
+
+// pickValues returns the given keys of v, including empty ones so that
+// attributes can be cleared.
+func pickValues(v url.Values, keys []string) url.Values {
+	out := url.Values{}
+	for _, k := range keys {
+		if vs := v[k]; len(vs) > 0 {
+			out[k] = vs
+		} else {
+			out.Set(k, "")
+		}
+	}
+	return out
+}
+
+// nonEmptyValues returns the values of v that are not empty.
+func nonEmptyValues(v url.Values) url.Values {
+	out := url.Values{}
+	for k, vs := range v {
+		if joinValues(vs) != "" {
+			out[k] = vs
+		}
+	}
+	return out
+}
+
+// sortedNames returns the keys of m in order.
+func sortedNames(m map[string]url.Values) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
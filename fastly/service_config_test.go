@@ -0,0 +1,403 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_ExportServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version/2/settings":
+			fmt.Fprint(w, `{"service_id":"foo","version":2,"general.default_ttl":3600}`)
+		case "/service/foo/version/2/backend":
+			fmt.Fprint(w, `[{"name":"origin","address":"example.com","port":443}]`)
+		case "/service/foo/version/2/domain":
+			fmt.Fprint(w, `[{"name":"www.example.com"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sc, err := c.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID:      "foo",
+		ServiceVersion: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.Settings == nil || sc.Settings.DefaultTTL != 3600 {
+		t.Errorf("bad settings: %#v", sc.Settings)
+	}
+	if len(sc.Backends) != 1 || sc.Backends[0].Name != "origin" {
+		t.Errorf("bad backends: %#v", sc.Backends)
+	}
+	if len(sc.Domains) != 1 || sc.Domains[0].Name != "www.example.com" {
+		t.Errorf("bad domains: %#v", sc.Domains)
+	}
+
+	b, err := json.Marshal(sc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ServiceConfig
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Backends) != 1 || decoded.Backends[0].Address != "example.com" {
+		t.Errorf("bad round trip: %s", b)
+	}
+	if strings.Contains(string(b), `"syslog"`) {
+		t.Errorf("expected empty resources to be omitted: %s", b)
+	}
+}
+
+func TestClient_ExportServiceConfig_error(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/service/foo/version/2/vcl" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/settings") {
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID:      "foo",
+		ServiceVersion: 2,
+	})
+	be, ok := err.(*BulkError)
+	if !ok {
+		t.Fatalf("expected *BulkError, got %T: %v", err, err)
+	}
+	if len(be.Errors) != 1 {
+		t.Errorf("expected 1 error, got %d", len(be.Errors))
+	}
+}
+
+func TestClient_ExportServiceConfig_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ExportServiceConfig(&ExportServiceConfigInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestDiffServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	current := &ServiceConfig{
+		Settings: &Settings{DefaultTTL: 3600},
+		Backends: []*Backend{
+			{Name: "origin", Address: "example.com", Port: 443},
+			{Name: "old", Address: "old.example.com"},
+		},
+		Conditions: []*Condition{{Name: "unused", Statement: "req.url ~ \"^/old\"", Type: "REQUEST"}},
+		Domains:    []*Domain{{Name: "www.example.com"}},
+	}
+	desired := &ServiceConfig{
+		Settings: &Settings{DefaultTTL: 3600},
+		Backends: []*Backend{
+			{Name: "origin", Address: "origin.example.com", Port: 443},
+		},
+		Domains: []*Domain{{Name: "www.example.com"}, {Name: "api.example.com"}},
+	}
+
+	got := DiffServiceConfig(current, desired)
+	for _, ch := range got {
+		ch.values = nil
+	}
+	want := []*ServiceConfigChange{
+		{Action: ServiceConfigUpdate, Resource: "backend", Name: "origin", Fields: []string{"address"}},
+		{Action: ServiceConfigCreate, Resource: "domain", Name: "api.example.com"},
+		{Action: ServiceConfigDelete, Resource: "backend", Name: "old"},
+		{Action: ServiceConfigDelete, Resource: "condition", Name: "unused"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		for _, ch := range got {
+			t.Logf("%#v", ch)
+		}
+		t.Errorf("bad changes")
+	}
+
+	if changes := DiffServiceConfig(current, current); len(changes) != 0 {
+		t.Errorf("expected no changes, got %d", len(changes))
+	}
+}
+
+func TestClient_ApplyServiceConfig_lists(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		}
+
+		switch {
+		case r.URL.Path == "/service/foo/version":
+			fmt.Fprint(w, `[{"number":1,"active":true,"locked":true}]`)
+		case r.URL.Path == "/service/foo/version/1/settings":
+			fmt.Fprint(w, `{}`)
+		case r.URL.Path == "/service/foo/version/1/healthcheck":
+			fmt.Fprint(w, `[{"name":"check","path":"/status","headers":["Host: example.com"]}]`)
+		case r.URL.Path == "/service/foo/version/1/clone":
+			fmt.Fprint(w, `{"number":2}`)
+		case r.Method == "GET":
+			fmt.Fprint(w, `[]`)
+		default:
+			fmt.Fprint(w, `{"status":"ok"}`)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(headers ...string) *ServiceConfig {
+		return &ServiceConfig{
+			Settings:     &Settings{},
+			HealthChecks: []*HealthCheck{{Name: "check", Path: "/status", Headers: headers}},
+		}
+	}
+
+	current, err := c.ExportServiceConfig(&ExportServiceConfigInput{ServiceID: "foo", ServiceVersion: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes := DiffServiceConfig(current, config("Host: example.com")); len(changes) != 0 {
+		t.Errorf("expected no changes, got %d", len(changes))
+	}
+	changes := DiffServiceConfig(current, config("Host: example.com", "X-Check: 1"))
+	if len(changes) != 1 || changes[0].Action != ServiceConfigUpdate || !reflect.DeepEqual(changes[0].Fields, []string{"headers[]"}) {
+		t.Fatalf("bad changes: %#v", changes)
+	}
+
+	res, err := c.ApplyServiceConfig(&ApplyServiceConfigInput{
+		ServiceID: "foo",
+		Config:    config("Host: example.com", "X-Check: 1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Changes) != 1 {
+		t.Errorf("expected 1 change, got %d", len(res.Changes))
+	}
+
+	want := []string{
+		"PUT /service/foo/version/1/clone ",
+		"PUT /service/foo/version/2/healthcheck/check headers%5B%5D=Host%3A+example.com&headers%5B%5D=X-Check%3A+1",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("bad requests:\nexpected: %q\n     got: %q", want, requests)
+	}
+}
+
+func TestClient_ApplyServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			body, _ := ioutil.ReadAll(r.Body)
+			mu.Lock()
+			requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+			mu.Unlock()
+		}
+
+		switch {
+		case r.URL.Path == "/service/foo/version":
+			fmt.Fprint(w, `[{"number":1,"active":true,"locked":true}]`)
+		case r.URL.Path == "/service/foo/version/1/settings":
+			fmt.Fprint(w, `{}`)
+		case r.URL.Path == "/service/foo/version/1/backend":
+			fmt.Fprint(w, `[{"name":"origin","address":"example.com","port":443},{"name":"old","address":"old.example.com"}]`)
+		case r.URL.Path == "/service/foo/version/1/clone":
+			fmt.Fprint(w, `{"number":2}`)
+		case r.URL.Path == "/service/foo/version/2/activate":
+			fmt.Fprint(w, `{"number":2,"active":true}`)
+		case r.Method == "GET":
+			fmt.Fprint(w, `[]`)
+		default:
+			fmt.Fprint(w, `{"status":"ok"}`)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := c.ApplyServiceConfig(&ApplyServiceConfigInput{
+		ServiceID: "foo",
+		Config: &ServiceConfig{
+			Settings: &Settings{},
+			Backends: []*Backend{{Name: "origin", Address: "origin.example.com", Port: 443}},
+			Domains:  []*Domain{{Name: "www.example.com"}},
+		},
+		Activate: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Version.Number != 2 || !res.Version.Active {
+		t.Errorf("bad version: %#v", res.Version)
+	}
+	if len(res.Changes) != 3 {
+		t.Errorf("expected 3 changes, got %d", len(res.Changes))
+	}
+
+	want := []string{
+		"PUT /service/foo/version/1/clone ",
+		"PUT /service/foo/version/2/backend/origin address=origin.example.com",
+		"POST /service/foo/version/2/domain name=www.example.com",
+		"DELETE /service/foo/version/2/backend/old ",
+		"PUT /service/foo/version/2/activate ",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("bad requests:\nexpected: %q\n     got: %q", want, requests)
+	}
+}
+
+func TestClient_ApplyServiceConfig_erls(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		}
+
+		switch {
+		case r.URL.Path == "/service/foo/version":
+			fmt.Fprint(w, `[{"number":1,"active":true,"locked":true}]`)
+		case r.URL.Path == "/service/foo/version/1/settings":
+			fmt.Fprint(w, `{}`)
+		case r.URL.Path == "/service/foo/version/1/rate-limiters":
+			fmt.Fprint(w, `[
+				{"id":"e1","name":"limit","action":"response","rps_limit":100,"window_size":10,"penalty_box_duration":5,"client_key":["req.http.Fastly-Client-IP"],"http_methods":["GET"],"response":{"status":429,"content_type":"text/plain","content":"slow"},"feature_revision":1},
+				{"id":"e2","name":"old","action":"log_only","rps_limit":100,"window_size":10,"penalty_box_duration":5,"feature_revision":1}
+			]`)
+		case r.URL.Path == "/service/foo/version/2/rate-limiters" && r.Method == "GET":
+			fmt.Fprint(w, `[{"id":"e3","name":"limit"},{"id":"e4","name":"old"}]`)
+		case r.URL.Path == "/service/foo/version/1/clone":
+			fmt.Fprint(w, `{"number":2}`)
+		case r.Method == "GET":
+			fmt.Fprint(w, `[]`)
+		default:
+			fmt.Fprint(w, `{"status":"ok"}`)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := c.ApplyServiceConfig(&ApplyServiceConfigInput{
+		ServiceID: "foo",
+		Config: &ServiceConfig{
+			Settings: &Settings{},
+			ERLs: []*ERL{
+				{
+					Name:               "limit",
+					Action:             ERLActionResponse,
+					RpsLimit:           200,
+					WindowSize:         ERLSize10,
+					PenaltyBoxDuration: 5,
+					ClientKey:          []string{"req.http.Fastly-Client-IP", "req.http.User-Agent"},
+					HttpMethods:        []string{"GET"},
+					Response:           &ERLResponseType{ERLStatus: 429, ERLContentType: "text/plain", ERLContent: "slow"},
+				},
+				{
+					Name:               "new",
+					Action:             ERLActionResponse,
+					RpsLimit:           50,
+					WindowSize:         ERLSize1,
+					PenaltyBoxDuration: 1,
+					ClientKey:          []string{"req.http.Fastly-Client-IP"},
+					HttpMethods:        []string{"POST"},
+					Response:           &ERLResponseType{ERLStatus: 429, ERLContentType: "text/plain", ERLContent: "slow"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Changes) != 3 {
+		t.Errorf("expected 3 changes, got %d", len(res.Changes))
+	}
+
+	want := []string{
+		"PUT /service/foo/version/1/clone ",
+		"PUT /rate-limiters/e3 client_key%5B%5D=req.http.Fastly-Client-IP&client_key%5B%5D=req.http.User-Agent&rps_limit=200",
+		"POST /service/foo/version/2/rate-limiters action=response&client_key%5B%5D=req.http.Fastly-Client-IP&http_methods%5B%5D=POST&name=new&penalty_box_duration=1&response%5Bcontent%5D=slow&response%5Bcontent_type%5D=text%2Fplain&response%5Bstatus%5D=429&rps_limit=50&window_size=1",
+		"DELETE /rate-limiters/e4 ",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("bad requests:\nexpected: %q\n     got: %q", want, requests)
+	}
+}
+
+func TestClient_ApplyServiceConfig_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ApplyServiceConfig(&ApplyServiceConfigInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ApplyServiceConfig(&ApplyServiceConfigInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingConfig {
+		t.Errorf("bad error: %s", err)
+	}
+}
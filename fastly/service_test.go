@@ -1,7 +1,16 @@
 package fastly
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/google/jsonapi"
 )
 
 func TestClient_Services(t *testing.T) {
@@ -217,3 +226,294 @@ func TestClient_DeleteService_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CreateServiceWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		valid    bool
+		activate bool
+		calls    []string
+	}{
+		{
+			name:     "activate",
+			valid:    true,
+			activate: true,
+			calls: []string{
+				"POST /service",
+				"POST /service/svc/version/1/domain",
+				"POST /service/svc/version/1/backend",
+				"GET /service/svc/version/1/validate",
+				"PUT /service/svc/version/1/activate",
+			},
+		},
+		{
+			name:  "rollback",
+			valid: false,
+			calls: []string{
+				"POST /service",
+				"POST /service/svc/version/1/domain",
+				"POST /service/svc/version/1/backend",
+				"GET /service/svc/version/1/validate",
+				"DELETE /service/svc",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var calls []string
+			var backend url.Values
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				mu.Unlock()
+				switch r.Method + " " + r.URL.Path {
+				case "POST /service":
+					fmt.Fprint(w, `{"id":"svc","name":"example"}`)
+				case "POST /service/svc/version/1/domain":
+					fmt.Fprint(w, `{"service_id":"svc","version":1,"name":"www.example.com"}`)
+				case "POST /service/svc/version/1/backend":
+					r.ParseForm()
+					backend = r.PostForm
+					fmt.Fprint(w, `{"service_id":"svc","version":1,"name":"origin.example.com"}`)
+				case "GET /service/svc/version/1/validate":
+					if tc.valid {
+						fmt.Fprint(w, `{"status":"ok"}`)
+					} else {
+						fmt.Fprint(w, `{"status":"error","msg":"missing default host"}`)
+					}
+				case "PUT /service/svc/version/1/activate":
+					fmt.Fprint(w, `{"service_id":"svc","number":1,"active":true}`)
+				case "DELETE /service/svc":
+					fmt.Fprint(w, `{"status":"ok"}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer ts.Close()
+
+			c, err := NewClient("", WithEndpoint(ts.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s, err := c.CreateServiceWithDefaults(&CreateServiceWithDefaultsInput{
+				Name:           "example",
+				Domain:         "www.example.com",
+				BackendAddress: "origin.example.com",
+				Activate:       tc.activate,
+			})
+			if tc.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if s.ID != "svc" || s.ActiveVersion != 1 {
+					t.Errorf("bad service: %+v", s)
+				}
+				if backend.Get("address") != "origin.example.com" || backend.Get("port") != "443" || backend.Get("use_ssl") != "1" {
+					t.Errorf("bad backend: %v", backend)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), "missing default host") {
+				t.Errorf("expected validation error, got %v", err)
+			}
+
+			if fmt.Sprint(calls) != fmt.Sprint(tc.calls) {
+				t.Errorf("expected calls %v, got %v", tc.calls, calls)
+			}
+		})
+	}
+}
+
+func TestClient_CreateServiceWithDefaults_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateServiceWithDefaults(&CreateServiceWithDefaultsInput{})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateServiceWithDefaults(&CreateServiceWithDefaultsInput{
+		Name: "foo",
+	})
+	if err != ErrMissingDomain {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateServiceWithDefaults(&CreateServiceWithDefaultsInput{
+		Name:   "foo",
+		Domain: "www.example.com",
+	})
+	if err != ErrMissingBackendAddress {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_SafeDeleteService(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		activations string
+		check       bool
+		blocked     bool
+		calls       []string
+	}{
+		{
+			name:        "deactivate",
+			activations: `{"data":[{"id":"act","type":"tls_activation","relationships":{"tls_domain":{"data":{"id":"www.example.com","type":"tls_domain"}}}}]}`,
+			calls: []string{
+				"GET /service/svc",
+				"PUT /service/svc/version/3/deactivate",
+				"DELETE /service/svc",
+			},
+		},
+		{
+			name:        "unused certificates",
+			activations: `{"data":[]}`,
+			check:       true,
+			calls: []string{
+				"GET /service/svc",
+				"GET /service/svc/version/3/domain",
+				"GET /tls/activations",
+				"PUT /service/svc/version/3/deactivate",
+				"DELETE /service/svc",
+			},
+		},
+		{
+			name:        "blocked",
+			activations: `{"data":[{"id":"act","type":"tls_activation","relationships":{"tls_domain":{"data":{"id":"www.example.com","type":"tls_domain"}}}}]}`,
+			check:       true,
+			blocked:     true,
+			calls: []string{
+				"GET /service/svc",
+				"GET /service/svc/version/3/domain",
+				"GET /tls/activations",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var calls []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				mu.Unlock()
+				switch r.Method + " " + r.URL.Path {
+				case "GET /service/svc":
+					fmt.Fprint(w, `{"id":"svc","versions":[{"number":2},{"number":3,"active":true}]}`)
+				case "GET /service/svc/version/3/domain":
+					fmt.Fprint(w, `[{"service_id":"svc","version":3,"name":"www.example.com"}]`)
+				case "GET /tls/activations":
+					if r.URL.Query().Get("filter[tls_domain.id]") != "www.example.com" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.Header().Set("Content-Type", jsonapi.MediaType)
+					fmt.Fprint(w, tc.activations)
+				case "PUT /service/svc/version/3/deactivate":
+					fmt.Fprint(w, `{"service_id":"svc","number":3,"active":false}`)
+				case "DELETE /service/svc":
+					fmt.Fprint(w, `{"status":"ok"}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer ts.Close()
+
+			c, err := NewClient("", WithEndpoint(ts.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = c.SafeDeleteService(&SafeDeleteServiceInput{
+				ID:                  "svc",
+				CheckTLSActivations: tc.check,
+			})
+			var blocked *ServiceDeletionBlockedError
+			if tc.blocked {
+				if !errors.As(err, &blocked) {
+					t.Fatalf("expected a ServiceDeletionBlockedError, got %v", err)
+				}
+				if blocked.ActiveVersion != 3 || len(blocked.TLSActivations) != 1 || blocked.TLSActivations[0].Domain.ID != "www.example.com" {
+					t.Errorf("bad error: %+v", blocked)
+				}
+				if !strings.Contains(err.Error(), "activation act for domain www.example.com") {
+					t.Errorf("bad error message: %s", err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if fmt.Sprint(calls) != fmt.Sprint(tc.calls) {
+				t.Errorf("expected calls %v, got %v", tc.calls, calls)
+			}
+		})
+	}
+}
+
+func TestClient_SafeDeleteService_validation(t *testing.T) {
+	err := testClient.SafeDeleteService(&SafeDeleteServiceInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListAllServices(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/service" || q.Get("sort") != "created" || q.Get("direction") != "descend" || q.Get("per_page") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch q.Get("page") {
+		case "1":
+			w.Header().Set("Link", `<https://api.fastly.com/service?page=2&per_page=2>; rel="next", <https://api.fastly.com/service?page=2&per_page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"id":"c","name":"c"},{"id":"a","name":"a"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":"b","name":"b"}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := &ListServicesInput{
+		PerPage:   2,
+		Sort:      "created",
+		Direction: "descend",
+	}
+
+	ss, err := c.ListAllServices(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, s := range ss {
+		ids = append(ids, s.ID)
+	}
+	if fmt.Sprint(ids) != "[c a b]" {
+		t.Errorf("bad services: %v", ids)
+	}
+
+	input.Page = 2
+	ss, err = c.ListServices(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 || ss[0].ID != "b" {
+		t.Errorf("bad page 2: %v", ss)
+	}
+}
@@ -0,0 +1,30 @@
+package fastly
+
+// ServiceVersionClient sends requests for one version of a service, so that
+// provisioning code creating many backends, domains, loggers and so on does
+// not pass the same ServiceID and ServiceVersion to every call. It is returned
+// by Client.ServiceVersion.
+//
+// Its methods are the methods of Client that take a ServiceID and
+// ServiceVersion. They call the Client method with a copy of the input whose
+// ServiceID and ServiceVersion are set to the bound ones, so those fields of
+// the input are ignored. The methods are generated into
+// service_version_client_gen.go by tools/service-version-gen; run "make
+// generate" after adding such a method to Client.
+type ServiceVersionClient struct {
+	client *Client
+
+	// ServiceID and ServiceVersion identify the bound service version.
+	ServiceID      string
+	ServiceVersion int
+}
+
+// ServiceVersion returns a ServiceVersionClient bound to the given version of
+// a service, which sends its requests with c.
+func (c *Client) ServiceVersion(serviceID string, serviceVersion int) *ServiceVersionClient {
+	return &ServiceVersionClient{
+		client:         c,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	}
+}
@@ -0,0 +1,78 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestServiceVersionClient_methods(t *testing.T) {
+	t.Parallel()
+
+	client := reflect.TypeOf(&Client{})
+	bound := reflect.TypeOf(&ServiceVersionClient{})
+	for i := 0; i < client.NumMethod(); i++ {
+		m := client.Method(i)
+		if m.Type.NumIn() != 2 || m.Type.In(1).Kind() != reflect.Ptr || m.Type.In(1).Elem().Kind() != reflect.Struct {
+			continue
+		}
+		in := m.Type.In(1).Elem()
+		id, ok1 := in.FieldByName("ServiceID")
+		version, ok2 := in.FieldByName("ServiceVersion")
+		if !ok1 || !ok2 || id.Type.Kind() != reflect.String || version.Type.Kind() != reflect.Int {
+			continue
+		}
+
+		bm, ok := bound.MethodByName(m.Name)
+		if !ok {
+			t.Errorf("ServiceVersionClient is missing Client.%s", m.Name)
+			continue
+		}
+		if !sameSignature(bm.Type, m.Type) {
+			t.Errorf("ServiceVersionClient.%s: signature %s differs from Client's %s", m.Name, bm.Type, m.Type)
+		}
+	}
+}
+
+func TestServiceVersionClient(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"service_id":"SU1Z0isxPaozGVKXdv0eY","version":3,"name":"example"}`)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sv := c.ServiceVersion("SU1Z0isxPaozGVKXdv0eY", 3)
+
+	in := &CreateBackendInput{ServiceID: "other", Name: "example", Address: "example.com"}
+	b, err := sv.CreateBackend(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Name != "example" {
+		t.Errorf("bad backend: %#v", b)
+	}
+	if in.ServiceID != "other" || in.ServiceVersion != 0 {
+		t.Errorf("the input was modified: %#v", in)
+	}
+
+	if _, err := sv.GetDomain(&GetDomainInput{Name: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /service/SU1Z0isxPaozGVKXdv0eY/version/3/backend",
+		"GET /service/SU1Z0isxPaozGVKXdv0eY/version/3/domain/example.com",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected requests %q, got %q", expected, paths)
+	}
+}
@@ -82,20 +82,20 @@ type CreateSplunkInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	Name              string      `url:"name,omitempty"`
-	URL               string      `url:"url,omitempty"`
-	RequestMaxEntries uint        `url:"request_max_entries,omitempty"`
-	RequestMaxBytes   uint        `url:"request_max_bytes,omitempty"`
-	Format            string      `url:"format,omitempty"`
-	FormatVersion     uint        `url:"format_version,omitempty"`
-	ResponseCondition string      `url:"response_condition,omitempty"`
-	Placement         string      `url:"placement,omitempty"`
-	Token             string      `url:"token,omitempty"`
-	UseTLS            Compatibool `url:"use_tls,omitempty"`
-	TLSCACert         string      `url:"tls_ca_cert,omitempty"`
-	TLSHostname       string      `url:"tls_hostname,omitempty"`
-	TLSClientCert     string      `url:"tls_client_cert,omitempty"`
-	TLSClientKey      string      `url:"tls_client_key,omitempty"`
+	Name              string `url:"name,omitempty"`
+	URL               string `url:"url,omitempty"`
+	RequestMaxEntries uint   `url:"request_max_entries,omitempty"`
+	RequestMaxBytes   uint   `url:"request_max_bytes,omitempty"`
+	Format            string `url:"format,omitempty"`
+	FormatVersion     uint   `url:"format_version,omitempty"`
+	ResponseCondition string `url:"response_condition,omitempty"`
+	Placement         string `url:"placement,omitempty"`
+	Token             string `url:"token,omitempty"`
+	UseTLS            *bool  `url:"use_tls,omitempty,int"`
+	TLSCACert         string `url:"tls_ca_cert,omitempty"`
+	TLSHostname       string `url:"tls_hostname,omitempty"`
+	TLSClientCert     string `url:"tls_client_cert,omitempty"`
+	TLSClientKey      string `url:"tls_client_key,omitempty"`
 }
 
 // CreateSplunk creates a new Fastly splunk.
@@ -171,20 +171,20 @@ type UpdateSplunkInput struct {
 	// Name is the name of the splunk to update.
 	Name string
 
-	NewName           *string      `url:"name,omitempty"`
-	URL               *string      `url:"url,omitempty"`
-	RequestMaxEntries *uint        `url:"request_max_entries,omitempty"`
-	RequestMaxBytes   *uint        `url:"request_max_bytes,omitempty"`
-	Format            *string      `url:"format,omitempty"`
-	FormatVersion     *uint        `url:"format_version,omitempty"`
-	ResponseCondition *string      `url:"response_condition,omitempty"`
-	Placement         *string      `url:"placement,omitempty"`
-	Token             *string      `url:"token,omitempty"`
-	UseTLS            *Compatibool `url:"use_tls,omitempty"`
-	TLSCACert         *string      `url:"tls_ca_cert,omitempty"`
-	TLSHostname       *string      `url:"tls_hostname,omitempty"`
-	TLSClientCert     *string      `url:"tls_client_cert,omitempty"`
-	TLSClientKey      *string      `url:"tls_client_key,omitempty"`
+	NewName           *string `url:"name,omitempty"`
+	URL               *string `url:"url,omitempty"`
+	RequestMaxEntries *uint   `url:"request_max_entries,omitempty"`
+	RequestMaxBytes   *uint   `url:"request_max_bytes,omitempty"`
+	Format            *string `url:"format,omitempty"`
+	FormatVersion     *uint   `url:"format_version,omitempty"`
+	ResponseCondition *string `url:"response_condition,omitempty"`
+	Placement         *string `url:"placement,omitempty"`
+	Token             *string `url:"token,omitempty"`
+	UseTLS            *bool   `url:"use_tls,omitempty,int"`
+	TLSCACert         *string `url:"tls_ca_cert,omitempty"`
+	TLSHostname       *string `url:"tls_hostname,omitempty"`
+	TLSClientCert     *string `url:"tls_client_cert,omitempty"`
+	TLSClientKey      *string `url:"tls_client_key,omitempty"`
 }
 
 // UpdateSplunk updates a specific splunk.
@@ -48,7 +48,7 @@ Wm7DCfrPNGVwFWUQOmsPue9rZBgO
 			FormatVersion:     2,
 			Placement:         "waf_debug",
 			Token:             "super-secure-token",
-			UseTLS:            true,
+			UseTLS:            Bool(true),
 			TLSCACert:         caCert,
 			TLSHostname:       "example.com",
 			TLSClientCert:     clientCert,
@@ -0,0 +1,99 @@
+package fastly
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/jsonapi"
+)
+
+// Star is a service the user has marked as a favorite.
+type Star struct {
+	ID        string     `jsonapi:"primary,star"`
+	CreatedAt *time.Time `jsonapi:"attr,created_at,iso8601"`
+	UpdatedAt *time.Time `jsonapi:"attr,updated_at,iso8601"`
+	DeletedAt *time.Time `jsonapi:"attr,deleted_at,iso8601"`
+	User      *SAUser    `jsonapi:"relation,user,omitempty"`
+	Service   *SAService `jsonapi:"relation,service,omitempty"`
+}
+
+// ListStars returns the services starred by the authenticated user.
+func (c *Client) ListStars() ([]*Star, error) {
+	resp, err := c.Get("/stars", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	stars, err := decodeMany[Star](resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return stars, nil
+}
+
+// CreateStarInput is used as input to the CreateStar function.
+type CreateStarInput struct {
+	// ServiceID is the ID of the service to star.
+	ServiceID string
+	// UserID is the ID of the user starring the service.
+	UserID string
+}
+
+// createStarPayload is the JSONAPI document sent to create a star.
+type createStarPayload struct {
+	ID      string     `jsonapi:"primary,star"`
+	User    *SAUser    `jsonapi:"relation,user"`
+	Service *SAService `jsonapi:"relation,service"`
+}
+
+// CreateStar stars a service on behalf of a user.
+func (c *Client) CreateStar(i *CreateStarInput) (*Star, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.UserID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	p := &createStarPayload{
+		User:    &SAUser{ID: i.UserID},
+		Service: &SAService{ID: i.ServiceID},
+	}
+
+	resp, err := c.PostJSONAPI("/stars", p, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var s Star
+	if err := jsonapi.UnmarshalPayload(resp.Body, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// DeleteStarInput is used as input to the DeleteStar function.
+type DeleteStarInput struct {
+	// ID of the star to delete.
+	ID string
+}
+
+// DeleteStar removes a star using its ID.
+func (c *Client) DeleteStar(i *DeleteStarInput) error {
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/stars/%s", i.ID)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
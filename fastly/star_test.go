@@ -0,0 +1,84 @@
+package fastly
+
+import "testing"
+
+func TestClient_Stars(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "stars/"
+
+	// Create
+	var err error
+	var s *Star
+	record(t, fixtureBase+"create", func(c *Client) {
+		s, err = c.CreateStar(&CreateStarInput{
+			ServiceID: testServiceID,
+			UserID:    "4tKBSuFhNEiIpNDxmmVydt",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, fixtureBase+"cleanup", func(c *Client) {
+			c.DeleteStar(&DeleteStarInput{
+				ID: s.ID,
+			})
+		})
+	}()
+
+	if s.Service.ID != testServiceID {
+		t.Errorf("bad service id: %v", s.Service.ID)
+	}
+	if s.User.ID != "4tKBSuFhNEiIpNDxmmVydt" {
+		t.Errorf("bad user id: %v", s.User.ID)
+	}
+
+	// List
+	var ss []*Star
+	record(t, fixtureBase+"list", func(c *Client) {
+		ss, err = c.ListStars()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 || ss[0].ID != s.ID {
+		t.Errorf("bad stars: %v", ss)
+	}
+
+	// Delete
+	record(t, fixtureBase+"delete", func(c *Client) {
+		err = c.DeleteStar(&DeleteStarInput{
+			ID: s.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_CreateStar_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateStar(&CreateStarInput{
+		UserID: "4tKBSuFhNEiIpNDxmmVydt",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateStar(&CreateStarInput{
+		ServiceID: testServiceID,
+	})
+	if err != ErrMissingUserID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteStar_validation(t *testing.T) {
+	err := testClient.DeleteStar(&DeleteStarInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
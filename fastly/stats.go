@@ -3,6 +3,9 @@ package fastly
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 )
 
 // Stats represent metrics of a Fastly service
@@ -80,6 +83,12 @@ type Stats struct {
 	MissHistogram             map[int]int `mapstructure:"miss_histogram"`           // Number of requests to origin in time buckets of 10s of milliseconds
 	BilledHeaderBytes         uint64      `mapstructure:"billed_header_bytes"`
 	BilledBodyBytes           uint64      `mapstructure:"billed_body_bytes"`
+	ServiceID                 string      `mapstructure:"service_id"` // ID of the service the stats belong to.
+	StartTime                 int64       `mapstructure:"start_time"` // Start of the sampling period as a Unix timestamp.
+
+	// Datacenter breaks the stats down by POP code when requested with
+	// GetStatsInput.Datacenter.
+	Datacenter map[string]*Stats `mapstructure:"datacenter"`
 }
 
 // GetStatsInput is an input to the GetStats function.
@@ -93,6 +102,8 @@ type GetStatsInput struct {
 	To      string
 	By      string
 	Region  string
+	// Datacenter additionally breaks the stats down by datacenter (POP).
+	Datacenter bool
 }
 
 // StatsResponse is a response from the service stats API endpoint
@@ -139,6 +150,105 @@ func (c *Client) GetStatsField(i *GetStatsInput) (*StatsFieldResponse, error) {
 	return sr, nil
 }
 
+// StatsPoint is a single sample of a StatsSeries.
+type StatsPoint struct {
+	StartTime time.Time
+	Value     float64
+}
+
+// StatsSeries is the time series of one stats field for a service, or for a
+// datacenter of a service when requested with GetStatsInput.Datacenter.
+type StatsSeries struct {
+	ServiceID  string
+	Datacenter string
+	Field      string
+	Points     []StatsPoint
+}
+
+// GetStatsFieldSeries returns the values of a single stats field as one time
+// series per service, and per datacenter if GetStatsInput.Datacenter is set.
+// The series are sorted by service ID and datacenter.
+func (c *Client) GetStatsFieldSeries(i *GetStatsInput) ([]*StatsSeries, error) {
+	if i.Field == "" {
+		return nil, ErrMissingStatsField
+	}
+
+	var resp struct {
+		Data interface{} `json:"data"`
+	}
+	if err := c.GetStatsJSON(i, &resp); err != nil {
+		return nil, err
+	}
+
+	// A single service returns a list of samples, while all services return
+	// the samples keyed by service ID.
+	var samples []interface{}
+	switch data := resp.Data.(type) {
+	case []interface{}:
+		samples = data
+	case map[string]interface{}:
+		for _, ss := range data {
+			if ss, ok := ss.([]interface{}); ok {
+				samples = append(samples, ss...)
+			}
+		}
+	}
+
+	type seriesKey struct{ service, datacenter string }
+	series := make(map[seriesKey]*StatsSeries)
+	add := func(k seriesKey, t time.Time, v interface{}) {
+		value, ok := v.(float64)
+		if !ok {
+			return
+		}
+		s, ok := series[k]
+		if !ok {
+			s = &StatsSeries{ServiceID: k.service, Datacenter: k.datacenter, Field: i.Field}
+			series[k] = s
+		}
+		s.Points = append(s.Points, StatsPoint{StartTime: t, Value: value})
+	}
+
+	for _, sample := range samples {
+		m, ok := sample.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service, _ := m["service_id"].(string)
+		if service == "" {
+			service = i.Service
+		}
+		start, _ := m["start_time"].(float64)
+		t := time.Unix(int64(start), 0).UTC()
+
+		if dcs, ok := m["datacenter"].(map[string]interface{}); ok {
+			for dc, stats := range dcs {
+				if stats, ok := stats.(map[string]interface{}); ok {
+					add(seriesKey{service, dc}, t, stats[i.Field])
+				}
+			}
+			continue
+		}
+		add(seriesKey{service, ""}, t, m[i.Field])
+	}
+
+	result := make([]*StatsSeries, 0, len(series))
+	for _, s := range series {
+		sort.Slice(s.Points, func(a, b int) bool {
+			return s.Points[a].StartTime.Before(s.Points[b].StartTime)
+		})
+		result = append(result, s)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].ServiceID != result[b].ServiceID {
+			return result[a].ServiceID < result[b].ServiceID
+		}
+		return result[a].Datacenter < result[b].Datacenter
+	})
+
+	return result, nil
+}
+
 // GetStatsJSON fetches stats and decodes the response directly to the JSON struct dst.
 func (c *Client) GetStatsJSON(i *GetStatsInput, dst interface{}) error {
 	p := "/stats"
@@ -151,13 +261,19 @@ func (c *Client) GetStatsJSON(i *GetStatsInput, dst interface{}) error {
 		p = fmt.Sprintf("%s/field/%s", p, i.Field)
 	}
 
+	params := map[string]string{
+		"from":   i.From,
+		"to":     i.To,
+		"by":     i.By,
+		"region": i.Region,
+	}
+	if i.Datacenter {
+		params["datacenter"] = "true"
+	}
+
 	r, err := c.Get(p, &RequestOptions{
-		Params: map[string]string{
-			"from":   i.From,
-			"to":     i.To,
-			"by":     i.By,
-			"region": i.Region,
-		},
+		Params:   params,
+		endpoint: c.statsURL,
 	})
 	if err != nil {
 		return err
@@ -177,8 +293,9 @@ type UsageStatsResponse struct {
 
 // Usage represents usage data of a single service or region
 type Usage struct {
-	Requests  uint64 `mapstructure:"requests"`
-	Bandwidth uint64 `mapstructure:"bandwidth"`
+	Requests        uint64 `mapstructure:"requests"`
+	Bandwidth       uint64 `mapstructure:"bandwidth"`
+	ComputeRequests uint64 `mapstructure:"compute_requests"`
 }
 
 // RegionsUsage is a list of aggregated usage data by Fastly's region
@@ -210,6 +327,7 @@ func (c *Client) GetUsage(i *GetUsageInput) (*UsageResponse, error) {
 			"by":     i.By,
 			"region": i.Region,
 		},
+		endpoint: c.statsURL,
 	})
 	if err != nil {
 		return nil, err
@@ -246,6 +364,7 @@ func (c *Client) GetUsageByService(i *GetUsageInput) (*UsageByServiceResponse, e
 			"by":     i.By,
 			"region": i.Region,
 		},
+		endpoint: c.statsURL,
 	})
 	if err != nil {
 		return nil, err
@@ -258,6 +377,106 @@ func (c *Client) GetUsageByService(i *GetUsageInput) (*UsageByServiceResponse, e
 	return sr, nil
 }
 
+// GetUsageByMonthInput is used as an input to the GetUsageByMonth function.
+type GetUsageByMonthInput struct {
+	Year  uint16
+	Month uint8
+	// BillableUnits reports bandwidth in GB and requests in units of 10,000,
+	// as they appear on invoices, rather than in bytes and requests.
+	BillableUnits bool
+}
+
+// MonthlyUsage is the usage of a month, broken down by service and region.
+type MonthlyUsage struct {
+	CustomerID string
+	// Services maps service IDs to the usage of that service.
+	Services map[string]*ServiceMonthlyUsage
+	// Total is the usage of all services by region.
+	Total RegionsUsage
+}
+
+// ServiceMonthlyUsage is the usage of a single service by region.
+type ServiceMonthlyUsage struct {
+	Name    string
+	Regions RegionsUsage
+}
+
+// UsageByMonthResponse is a response from the monthly usage API endpoint
+type UsageByMonthResponse struct {
+	Status  string
+	Meta    map[string]string
+	Message string
+	Data    *MonthlyUsage
+}
+
+// GetUsageByMonth returns the usage of a calendar month by service and region,
+// as used for cost attribution.
+func (c *Client) GetUsageByMonth(i *GetUsageByMonthInput) (*UsageByMonthResponse, error) {
+	if i.Year == 0 {
+		return nil, ErrMissingYear
+	}
+
+	if i.Month == 0 {
+		return nil, ErrMissingMonth
+	}
+
+	params := map[string]string{
+		"year":  strconv.Itoa(int(i.Year)),
+		"month": fmt.Sprintf("%02d", i.Month),
+	}
+	if i.BillableUnits {
+		params["billable_units"] = "true"
+	}
+
+	r, err := c.Get("/stats/usage_by_month", &RequestOptions{
+		Params:   params,
+		endpoint: c.statsURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Each service object holds its name next to the per-region usage, so the
+	// services are decoded by hand.
+	var raw struct {
+		Status  string            `mapstructure:"status"`
+		Meta    map[string]string `mapstructure:"meta"`
+		Message string            `mapstructure:"msg"`
+		Data    struct {
+			CustomerID string                            `mapstructure:"customer_id"`
+			Services   map[string]map[string]interface{} `mapstructure:"services"`
+			Total      RegionsUsage                      `mapstructure:"total"`
+		} `mapstructure:"data"`
+	}
+	if err := decodeBodyMap(r.Body, &raw); err != nil {
+		return nil, err
+	}
+
+	mu := &MonthlyUsage{
+		CustomerID: raw.Data.CustomerID,
+		Services:   make(map[string]*ServiceMonthlyUsage, len(raw.Data.Services)),
+		Total:      raw.Data.Total,
+	}
+	for id, m := range raw.Data.Services {
+		su := &ServiceMonthlyUsage{}
+		if name, ok := m["name"].(string); ok {
+			su.Name = name
+		}
+		delete(m, "name")
+		if err := decodeMap(m, &su.Regions); err != nil {
+			return nil, err
+		}
+		mu.Services[id] = su
+	}
+
+	return &UsageByMonthResponse{
+		Status:  raw.Status,
+		Meta:    raw.Meta,
+		Message: raw.Message,
+		Data:    mu,
+	}, nil
+}
+
 // RegionsResponse is a response from Fastly regions API endpoint
 type RegionsResponse struct {
 	Status  string            `mapstructure:"status"`
@@ -268,7 +487,9 @@ type RegionsResponse struct {
 
 // GetRegions returns a list of Fastly regions
 func (c *Client) GetRegions() (*RegionsResponse, error) {
-	r, err := c.Get("stats/regions", nil)
+	r, err := c.Get("stats/regions", &RequestOptions{
+		endpoint: c.statsURL,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,159 @@
+package fastly
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// statsColumn is a scalar field of Stats exported by WriteCSV and
+// WriteNDJSON.
+type statsColumn struct {
+	name  string
+	index int
+}
+
+// statsColumns are the scalar fields of Stats in declaration order, named
+// after their API field names.
+var statsColumns = func() []statsColumn {
+	var cols []statsColumn
+	t := reflect.TypeOf(Stats{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		switch f.Type.Kind() {
+		case reflect.Uint64, reflect.Int64, reflect.Float64, reflect.String:
+		default:
+			continue
+		}
+		name := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if name == "" {
+			continue
+		}
+		cols = append(cols, statsColumn{name: name, index: i})
+	}
+	return cols
+}()
+
+// csvRecord returns the values of the scalar fields of s, in the order of
+// statsColumns.
+func (s *Stats) csvRecord() []string {
+	v := reflect.ValueOf(s).Elem()
+	record := make([]string, len(statsColumns))
+	for i, col := range statsColumns {
+		f := v.Field(col.index)
+		switch f.Kind() {
+		case reflect.Uint64:
+			record[i] = strconv.FormatUint(f.Uint(), 10)
+		case reflect.Int64:
+			record[i] = strconv.FormatInt(f.Int(), 10)
+		case reflect.Float64:
+			record[i] = strconv.FormatFloat(f.Float(), 'f', -1, 64)
+		case reflect.String:
+			record[i] = f.String()
+		}
+	}
+	return record
+}
+
+// jsonObject returns s keyed by API field names, including the miss histogram
+// and the datacenter breakdown.
+func (s *Stats) jsonObject() map[string]interface{} {
+	v := reflect.ValueOf(s).Elem()
+	obj := make(map[string]interface{}, len(statsColumns)+2)
+	for _, col := range statsColumns {
+		obj[col.name] = v.Field(col.index).Interface()
+	}
+	if len(s.MissHistogram) > 0 {
+		obj["miss_histogram"] = s.MissHistogram
+	}
+	if len(s.Datacenter) > 0 {
+		dcs := make(map[string]interface{}, len(s.Datacenter))
+		for dc, ds := range s.Datacenter {
+			dcs[dc] = ds.jsonObject()
+		}
+		obj["datacenter"] = dcs
+	}
+	return obj
+}
+
+// writeStatsCSV writes a header row followed by one row per entry of stats.
+func writeStatsCSV(w io.Writer, stats []*Stats) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(statsColumns))
+	for i, col := range statsColumns {
+		header[i] = col.name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, s := range stats {
+		if err := cw.Write(s.csvRecord()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeStatsNDJSON writes one JSON object per line per entry of stats.
+func writeStatsNDJSON(w io.Writer, stats []*Stats) error {
+	enc := json.NewEncoder(w)
+	for _, s := range stats {
+		if err := enc.Encode(s.jsonObject()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes the stats as CSV to w: a header row with the API field
+// names, then one row per sampling period. The miss histogram and the
+// datacenter breakdown are not included, use WriteNDJSON for those.
+func (r *StatsResponse) WriteCSV(w io.Writer) error {
+	return writeStatsCSV(w, r.Data)
+}
+
+// WriteNDJSON writes the stats as newline delimited JSON to w, one object per
+// sampling period, keyed by the API field names.
+func (r *StatsResponse) WriteNDJSON(w io.Writer) error {
+	return writeStatsNDJSON(w, r.Data)
+}
+
+// flatten returns the stats of all services, sorted by service ID.
+func (r *StatsFieldResponse) flatten() []*Stats {
+	services := make([]string, 0, len(r.Data))
+	for id := range r.Data {
+		services = append(services, id)
+	}
+	sort.Strings(services)
+
+	var stats []*Stats
+	for _, id := range services {
+		for _, s := range r.Data[id] {
+			if s.ServiceID == "" {
+				c := *s
+				c.ServiceID = id
+				s = &c
+			}
+			stats = append(stats, s)
+		}
+	}
+	return stats
+}
+
+// WriteCSV writes the stats of all services as CSV to w, like
+// StatsResponse.WriteCSV. Rows are grouped by service, in order of service ID.
+func (r *StatsFieldResponse) WriteCSV(w io.Writer) error {
+	return writeStatsCSV(w, r.flatten())
+}
+
+// WriteNDJSON writes the stats of all services as newline delimited JSON to
+// w, like StatsResponse.WriteNDJSON. Objects are grouped by service, in order
+// of service ID.
+func (r *StatsFieldResponse) WriteNDJSON(w io.Writer) error {
+	return writeStatsNDJSON(w, r.flatten())
+}
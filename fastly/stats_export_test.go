@@ -0,0 +1,106 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestStatsResponse_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	r := &StatsResponse{Data: []*Stats{
+		{ServiceID: "abc", StartTime: 1600000000, Requests: 10, HitRatio: 0.5},
+		{ServiceID: "abc", StartTime: 1600000060, Requests: 20, MissHistogram: map[int]int{10: 1}},
+	}}
+
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+
+	header := records[0]
+	col := func(name string) int {
+		for i, h := range header {
+			if h == name {
+				return i
+			}
+		}
+		t.Fatalf("missing column %q", name)
+		return -1
+	}
+	for _, name := range []string{"miss_histogram", "datacenter"} {
+		for _, h := range header {
+			if h == name {
+				t.Errorf("unexpected column %q", name)
+			}
+		}
+	}
+
+	if got := records[1][col("requests")]; got != "10" {
+		t.Errorf("bad requests: %q", got)
+	}
+	if got := records[1][col("hit_ratio")]; got != "0.5" {
+		t.Errorf("bad hit_ratio: %q", got)
+	}
+	if got := records[2][col("start_time")]; got != "1600000060" {
+		t.Errorf("bad start_time: %q", got)
+	}
+	if got := records[2][col("service_id")]; got != "abc" {
+		t.Errorf("bad service_id: %q", got)
+	}
+}
+
+func TestStatsFieldResponse_WriteNDJSON(t *testing.T) {
+	t.Parallel()
+
+	r := &StatsFieldResponse{Data: map[string][]*Stats{
+		"svc-b": {{Requests: 2}},
+		"svc-a": {{Requests: 1, Datacenter: map[string]*Stats{"LHR": {Requests: 1}}}},
+	}}
+
+	var buf bytes.Buffer
+	if err := r.WriteNDJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+
+	var objs []map[string]interface{}
+	for _, l := range lines {
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(l), &obj); err != nil {
+			t.Fatal(err)
+		}
+		objs = append(objs, obj)
+	}
+
+	if objs[0]["service_id"] != "svc-a" || objs[0]["requests"] != float64(1) {
+		t.Errorf("bad first object: %v", objs[0])
+	}
+	dc, ok := objs[0]["datacenter"].(map[string]interface{})
+	if !ok || dc["LHR"] == nil {
+		t.Errorf("bad datacenter breakdown: %v", objs[0]["datacenter"])
+	}
+	if objs[1]["service_id"] != "svc-b" || objs[1]["requests"] != float64(2) {
+		t.Errorf("bad second object: %v", objs[1])
+	}
+
+	// The response itself is left untouched.
+	if r.Data["svc-a"][0].ServiceID != "" {
+		t.Errorf("response was modified")
+	}
+}
@@ -1,7 +1,9 @@
 package fastly
 
 import (
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestClient_GetStats(t *testing.T) {
@@ -130,3 +132,136 @@ func TestClient_GetServicesByRegionsUsage(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClient_GetUsageByMonth(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ur *UsageByMonthResponse
+	record(t, "stats/usage_by_month", func(c *Client) {
+		ur, err = c.GetUsageByMonth(&GetUsageByMonthInput{
+			Year:  2022,
+			Month: 5,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	su, ok := ur.Data.Services[testServiceID]
+	if !ok {
+		t.Fatalf("missing service usage: %v", ur.Data.Services)
+	}
+	if su.Name != "go-fastly-test" {
+		t.Errorf("bad name: %q", su.Name)
+	}
+	if u := su.Regions["europe"]; u == nil || u.Requests != 1200 || u.Bandwidth != 53000 {
+		t.Errorf("bad europe usage: %+v", u)
+	}
+	if u := ur.Data.Total["usa"]; u == nil || u.Requests != 3400 {
+		t.Errorf("bad total usa usage: %+v", u)
+	}
+}
+
+func TestClient_GetUsageByMonth_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetUsageByMonth(&GetUsageByMonthInput{
+		Month: 5,
+	})
+	if err != ErrMissingYear {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetUsageByMonth(&GetUsageByMonthInput{
+		Year: 2022,
+	})
+	if err != ErrMissingMonth {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetStatsFieldSeries(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var series []*StatsSeries
+	record(t, "stats/service_stats_by_field_and_service", func(c *Client) {
+		series, err = c.GetStatsFieldSeries(&GetStatsInput{
+			Service: testServiceID,
+			Field:   "bandwidth",
+			From:    "10 days ago",
+			To:      "now",
+			By:      "day",
+			Region:  "usa",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series) != 1 {
+		t.Fatalf("expected 1 series, got %d", len(series))
+	}
+	s := series[0]
+	if s.ServiceID != testServiceID || s.Datacenter != "" || s.Field != "bandwidth" {
+		t.Errorf("bad series: %+v", s)
+	}
+	if len(s.Points) == 0 {
+		t.Fatal("missing points")
+	}
+	if p := s.Points[0]; p.Value != 1845740 || !p.StartTime.Equal(time.Unix(1644019200, 0)) {
+		t.Errorf("bad first point: %+v", p)
+	}
+}
+
+func TestClient_GetStatsFieldSeries_datacenter(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var series []*StatsSeries
+	record(t, "stats/field_by_datacenter", func(c *Client) {
+		series, err = c.GetStatsFieldSeries(&GetStatsInput{
+			Field:      "requests",
+			From:       "2 hours ago",
+			To:         "now",
+			By:         "hour",
+			Region:     "europe",
+			Datacenter: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		service, datacenter string
+		values              []float64
+	}{
+		{"7i6HN3TK9wS159v2gPAZ8A", "AMS", []float64{120, 140}},
+		{"7i6HN3TK9wS159v2gPAZ8A", "LHR", []float64{80, 95}},
+		{"kKJb5bOFI47uHeBVluGfX1", "AMS", []float64{10, 12}},
+	}
+	if len(series) != len(expected) {
+		t.Fatalf("expected %d series, got %d", len(expected), len(series))
+	}
+	for n, e := range expected {
+		s := series[n]
+		if s.ServiceID != e.service || s.Datacenter != e.datacenter {
+			t.Errorf("series %d: expected %s/%s, got %s/%s", n, e.service, e.datacenter, s.ServiceID, s.Datacenter)
+			continue
+		}
+		var values []float64
+		for _, p := range s.Points {
+			values = append(values, p.Value)
+		}
+		if !reflect.DeepEqual(values, e.values) {
+			t.Errorf("series %d: expected %v, got %v", n, e.values, values)
+		}
+	}
+}
+
+func TestClient_GetStatsFieldSeries_validation(t *testing.T) {
+	_, err := testClient.GetStatsFieldSeries(&GetStatsInput{})
+	if err != ErrMissingStatsField {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -84,22 +84,22 @@ type CreateSyslogInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	Name              string      `url:"name,omitempty"`
-	Address           string      `url:"address,omitempty"`
-	Hostname          string      `url:"hostname,omitempty"`
-	Port              uint        `url:"port,omitempty"`
-	UseTLS            Compatibool `url:"use_tls,omitempty"`
-	IPV4              string      `url:"ipv4,omitempty"`
-	TLSCACert         string      `url:"tls_ca_cert,omitempty"`
-	TLSHostname       string      `url:"tls_hostname,omitempty"`
-	TLSClientCert     string      `url:"tls_client_cert,omitempty"`
-	TLSClientKey      string      `url:"tls_client_key,omitempty"`
-	Token             string      `url:"token,omitempty"`
-	Format            string      `url:"format,omitempty"`
-	FormatVersion     uint        `url:"format_version,omitempty"`
-	MessageType       string      `url:"message_type,omitempty"`
-	ResponseCondition string      `url:"response_condition,omitempty"`
-	Placement         string      `url:"placement,omitempty"`
+	Name              string `url:"name,omitempty"`
+	Address           string `url:"address,omitempty"`
+	Hostname          string `url:"hostname,omitempty"`
+	Port              uint   `url:"port,omitempty"`
+	UseTLS            *bool  `url:"use_tls,omitempty,int"`
+	IPV4              string `url:"ipv4,omitempty"`
+	TLSCACert         string `url:"tls_ca_cert,omitempty"`
+	TLSHostname       string `url:"tls_hostname,omitempty"`
+	TLSClientCert     string `url:"tls_client_cert,omitempty"`
+	TLSClientKey      string `url:"tls_client_key,omitempty"`
+	Token             string `url:"token,omitempty"`
+	Format            string `url:"format,omitempty"`
+	FormatVersion     uint   `url:"format_version,omitempty"`
+	MessageType       string `url:"message_type,omitempty"`
+	ResponseCondition string `url:"response_condition,omitempty"`
+	Placement         string `url:"placement,omitempty"`
 }
 
 // CreateSyslog creates a new Fastly syslog.
@@ -175,22 +175,22 @@ type UpdateSyslogInput struct {
 	// Name is the name of the syslog to update.
 	Name string
 
-	NewName           *string      `url:"name,omitempty"`
-	Address           *string      `url:"address,omitempty"`
-	Hostname          *string      `url:"hostname,omitempty"`
-	Port              *uint        `url:"port,omitempty"`
-	UseTLS            *Compatibool `url:"use_tls,omitempty"`
-	IPV4              *string      `url:"ipv4,omitempty"`
-	TLSCACert         *string      `url:"tls_ca_cert,omitempty"`
-	TLSHostname       *string      `url:"tls_hostname,omitempty"`
-	TLSClientCert     *string      `url:"tls_client_cert,omitempty"`
-	TLSClientKey      *string      `url:"tls_client_key,omitempty"`
-	Token             *string      `url:"token,omitempty"`
-	Format            *string      `url:"format,omitempty"`
-	FormatVersion     *uint        `url:"format_version,omitempty"`
-	MessageType       *string      `url:"message_type,omitempty"`
-	ResponseCondition *string      `url:"response_condition,omitempty"`
-	Placement         *string      `url:"placement,omitempty"`
+	NewName           *string `url:"name,omitempty"`
+	Address           *string `url:"address,omitempty"`
+	Hostname          *string `url:"hostname,omitempty"`
+	Port              *uint   `url:"port,omitempty"`
+	UseTLS            *bool   `url:"use_tls,omitempty,int"`
+	IPV4              *string `url:"ipv4,omitempty"`
+	TLSCACert         *string `url:"tls_ca_cert,omitempty"`
+	TLSHostname       *string `url:"tls_hostname,omitempty"`
+	TLSClientCert     *string `url:"tls_client_cert,omitempty"`
+	TLSClientKey      *string `url:"tls_client_key,omitempty"`
+	Token             *string `url:"token,omitempty"`
+	Format            *string `url:"format,omitempty"`
+	FormatVersion     *uint   `url:"format_version,omitempty"`
+	MessageType       *string `url:"message_type,omitempty"`
+	ResponseCondition *string `url:"response_condition,omitempty"`
+	Placement         *string `url:"placement,omitempty"`
 }
 
 // UpdateSyslog updates a specific syslog.
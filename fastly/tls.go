@@ -73,20 +73,11 @@ func (c *Client) ListPrivateKeys(i *ListPrivateKeysInput) ([]*PrivateKey, error)
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(PrivateKey)))
+	ppk, err := decodeMany[PrivateKey](r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	ppk := make([]*PrivateKey, len(data))
-	for i := range data {
-		typed, ok := data[i].(*PrivateKey)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-PrivateKey response")
-		}
-		ppk[i] = typed
-	}
-
 	return ppk, nil
 }
 
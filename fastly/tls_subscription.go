@@ -2,7 +2,6 @@ package fastly
 
 import (
 	"fmt"
-	"reflect"
 	"strconv"
 	"time"
 
@@ -38,11 +37,14 @@ type TLSAuthorizations struct {
 }
 
 // TLSChallenge represents a DNS record to be added for a specific type of domain ownership challenge
+//
+// Nested attributes are decoded using their jsonapi tags but encoded with
+// encoding/json, so both tags are needed for the encoding to round-trip.
 type TLSChallenge struct {
-	Type       string   `jsonapi:"attr,type"`
-	RecordType string   `jsonapi:"attr,record_type"`
-	RecordName string   `jsonapi:"attr,record_name"`
-	Values     []string `jsonapi:"attr,values"`
+	Type       string   `jsonapi:"attr,type" json:"type"`
+	RecordType string   `jsonapi:"attr,record_type" json:"record_type"`
+	RecordName string   `jsonapi:"attr,record_name" json:"record_name"`
+	Values     []string `jsonapi:"attr,values" json:"values"`
 }
 
 // ListTLSSubscriptionsInput is used as input to the ListTLSSubscriptions function
@@ -100,21 +102,11 @@ func (c *Client) ListTLSSubscriptions(i *ListTLSSubscriptionsInput) ([]*TLSSubsc
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(response.Body, reflect.TypeOf(new(TLSSubscription)))
+	subscriptions, err := decodeMany[TLSSubscription](response.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert slice of interface{}s to a slice of TLSSubscription structs
-	subscriptions := make([]*TLSSubscription, len(data))
-	for i := range data {
-		typed, ok := data[i].(*TLSSubscription)
-		if !ok {
-			return nil, fmt.Errorf("unexpected response type: %T", data[i])
-		}
-		subscriptions[i] = typed
-	}
-
 	return subscriptions, nil
 }
 
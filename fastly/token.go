@@ -105,6 +105,63 @@ func (c *Client) GetTokenSelf() (*Token, error) {
 	return t, nil
 }
 
+// OTPHeader is the name of the header carrying the one-time password of a
+// user with two-factor authentication, see SudoInput.OTP.
+const OTPHeader = "Fastly-OTP"
+
+// SudoInput is used as input to the Sudo function.
+type SudoInput struct {
+	// Username and Password are the credentials of the user (required).
+	Username string `url:"username"`
+	Password string `url:"password"`
+
+	// OTP is the current one-time password of a user with two-factor
+	// authentication, sent as the OTPHeader.
+	OTP string `url:"-"`
+
+	// ExpiryTime, if set, ends the elevated session at the given time
+	// instead of the default of 5 minutes.
+	ExpiryTime *time.Time `url:"expiry_time,omitempty"`
+}
+
+// SudoSession is an elevated (sudo) session of an API token.
+type SudoSession struct {
+	ExpiryTime *time.Time `mapstructure:"expiry_time"`
+}
+
+// Sudo re-authenticates the user of the client's token, elevating the token
+// until the returned session expires. Sensitive endpoints, such as creating
+// and deleting tokens or managing users, fail with a 403 Forbidden error
+// without an elevated token. RequestOptions.Sudo elevates the token before a
+// single request instead.
+func (c *Client) Sudo(i *SudoInput) (*SudoSession, error) {
+	return c.sudo(i, &RequestOptions{})
+}
+
+// sudo elevates a token like Sudo, sending the request with ro, which sets
+// the token to elevate, if not the client's, and the Context and Timeout.
+func (c *Client) sudo(i *SudoInput, ro *RequestOptions) (*SudoSession, error) {
+	if i.Username == "" {
+		return nil, ErrMissingUsername
+	}
+
+	if i.Password == "" {
+		return nil, ErrMissingPassword
+	}
+
+	ro.OTP = i.OTP
+	resp, err := c.PostForm("/sudo", i, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *SudoSession
+	if err := decodeBodyMap(resp.Body, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // CreateTokenInput is used as input to the Token function.
 type CreateTokenInput struct {
 	Name      string     `url:"name,omitempty"`
@@ -113,16 +170,26 @@ type CreateTokenInput struct {
 	Password  string     `url:"password,omitempty"`
 	Services  []string   `url:"services,brackets,omitempty"`
 	ExpiresAt *time.Time `url:"expires_at,omitempty"`
+
+	// OTP is the current one-time password of a user with two-factor
+	// authentication.
+	OTP string `url:"-"`
 }
 
-// CreateToken creates a new API token with the given information.
+// CreateToken creates a new API token with the given information. The
+// Username and Password (and OTP, if any) are used to elevate the client's
+// token with Sudo first.
 func (c *Client) CreateToken(i *CreateTokenInput) (*Token, error) {
-	_, err := c.PostForm("/sudo", i, nil)
+	_, err := c.Sudo(&SudoInput{
+		Username: i.Username,
+		Password: i.Password,
+		OTP:      i.OTP,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.PostForm("/tokens", i, nil)
+	resp, err := c.PostForm("/tokens", i, &RequestOptions{OTP: i.OTP})
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +204,14 @@ func (c *Client) CreateToken(i *CreateTokenInput) (*Token, error) {
 // DeleteTokenInput is used as input to the DeleteToken function.
 type DeleteTokenInput struct {
 	TokenID string
+
+	// OTP is the current one-time password of a user with two-factor
+	// authentication.
+	OTP string
+
+	// Sudo, if set, elevates the client's token with Sudo before the
+	// request.
+	Sudo *SudoInput
 }
 
 // DeleteToken revokes a specific token by its ID.
@@ -146,7 +221,7 @@ func (c *Client) DeleteToken(i *DeleteTokenInput) error {
 	}
 
 	path := fmt.Sprintf("/tokens/%s", i.TokenID)
-	resp, err := c.Delete(path, nil)
+	resp, err := c.Delete(path, &RequestOptions{OTP: i.OTP, Sudo: i.Sudo})
 	if err != nil {
 		return err
 	}
@@ -173,6 +248,14 @@ func (c *Client) DeleteTokenSelf() error {
 // BatchDeleteTokensInput is used as input to BatchDeleteTokens.
 type BatchDeleteTokensInput struct {
 	Tokens []*BatchToken
+
+	// OTP is the current one-time password of a user with two-factor
+	// authentication.
+	OTP string
+
+	// Sudo, if set, elevates the client's token with Sudo before the
+	// request.
+	Sudo *SudoInput
 }
 
 // BatchToken represents the JSONAPI data to be sent to the API.
@@ -186,6 +269,6 @@ func (c *Client) BatchDeleteTokens(i *BatchDeleteTokensInput) error {
 	if len(i.Tokens) == 0 {
 		return ErrMissingTokensValue
 	}
-	_, err := c.DeleteJSONAPIBulk("/tokens", i.Tokens, nil)
+	_, err := c.DeleteJSONAPIBulk("/tokens", i.Tokens, &RequestOptions{OTP: i.OTP, Sudo: i.Sudo})
 	return err
 }
@@ -0,0 +1,78 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+)
+
+// TokenRefresher returns a new API token for the client, e.g. from a secrets
+// manager, after the API rejected the current one. See WithTokenRefresher.
+type TokenRefresher func(ctx context.Context) (string, error)
+
+// getAPIKey returns the API key the client authenticates with.
+func (c *Client) getAPIKey() string {
+	c.apiKeyLock.RLock()
+	defer c.apiKeyLock.RUnlock()
+	return c.apiKey
+}
+
+// refreshAPIKey replaces the API key used, which the API rejected, with one
+// from the client's TokenRefresher and returns the new key. ok is false if
+// used is not the client's key, such as a RequestOptions.Token, or if the
+// refresh failed. Concurrent requests rejected with the same key share a
+// single refresh.
+func (c *Client) refreshAPIKey(ctx context.Context, used string) (key string, ok bool) {
+	c.apiKeyLock.Lock()
+	defer c.apiKeyLock.Unlock()
+
+	switch used {
+	case c.apiKey:
+	case c.replacedAPIKey:
+		// Another request has already refreshed the key.
+		return c.apiKey, true
+	default:
+		return "", false
+	}
+
+	key, err := c.tokenRefresher(ctx)
+	if err != nil {
+		c.logf("[fastly] refreshing the API token failed: %v", err)
+		return "", false
+	}
+	c.replacedAPIKey, c.apiKey = c.apiKey, key
+	return key, true
+}
+
+// doOnceRefreshing sends the request like doOnce. If the API rejects the
+// client's token with a 401 Unauthorized response and the client has a
+// TokenRefresher, the token is refreshed and the request sent again once.
+// Requests sent without a token are never given one.
+func (c *Client) doOnceRefreshing(req *http.Request) (*http.Response, error) {
+	resp, err := c.doOnce(req)
+	if c.tokenRefresher == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized || req.Header.Get(APIKeyHeader) == "" {
+		return resp, err
+	}
+
+	key, ok := c.refreshAPIKey(req.Context(), req.Header.Get(APIKeyHeader))
+	if !ok {
+		return resp, err
+	}
+
+	// A body that cannot be rewound cannot be sent again.
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return resp, err
+		}
+		body, berr := req.GetBody()
+		if berr != nil {
+			return resp, err
+		}
+		req.Body = body
+	}
+	if resp.Body != nil {
+		resp.Body.Close()
+	}
+
+	req.Header.Set(APIKeyHeader, key)
+	return c.doOnce(req)
+}
@@ -1,6 +1,12 @@
 package fastly
 
-import "testing"
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
 
 func TestClient_ListTokens(t *testing.T) {
 	t.Parallel()
@@ -146,3 +152,82 @@ func TestClient_CreateAndBulkDeleteTokens(t *testing.T) {
 		t.Fatal(deleteErr)
 	}
 }
+
+func TestClient_Sudo(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var s *SudoSession
+	record(t, "tokens/sudo", func(c *Client) {
+		s, err = c.Sudo(&SudoInput{
+			Username: "XXXXXXXXXXXXXXXXXXXXXX",
+			Password: "XXXXXXXXXXXXXXXXXXXXXX",
+			OTP:      "123456",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ExpiryTime == nil || !s.ExpiryTime.Equal(time.Date(2022, 6, 20, 9, 10, 32, 0, time.UTC)) {
+		t.Errorf("bad expiry time: %v", s.ExpiryTime)
+	}
+}
+
+func TestClient_Sudo_validation(t *testing.T) {
+	var err error
+	_, err = testClient.Sudo(&SudoInput{
+		Password: "secret",
+	})
+	if err != ErrMissingUsername {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.Sudo(&SudoInput{
+		Username: "user@example.com",
+	})
+	if err != ErrMissingPassword {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteToken_sudo(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" otp="+r.Header.Get(OTPHeader))
+		if r.URL.Path == "/sudo" {
+			w.Write([]byte(`{"expiry_time":"2022-06-20T09:10:32+00:00"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sudo := &SudoInput{Username: "user@example.com", Password: "secret", OTP: "123456"}
+	if err := c.DeleteToken(&DeleteTokenInput{TokenID: "abc", OTP: "654321", Sudo: sudo}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.BatchDeleteTokens(&BatchDeleteTokensInput{
+		Tokens: []*BatchToken{{ID: "abc"}, {ID: "def"}},
+		OTP:    "654321",
+		Sudo:   sudo,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /sudo otp=123456",
+		"DELETE /tokens/abc otp=654321",
+		"POST /sudo otp=123456",
+		"DELETE /tokens otp=654321",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests)
+	}
+}
@@ -25,6 +25,42 @@ type User struct {
 	DeletedAt              *time.Time `mapstructure:"deleted_at"`
 }
 
+// User roles, from least to most privileged.
+const (
+	UserRoleUser      = "user"
+	UserRoleBilling   = "billing"
+	UserRoleEngineer  = "engineer"
+	UserRoleSuperuser = "superuser"
+)
+
+// TwoFactorStatus describes the state of two-factor authentication of a user.
+type TwoFactorStatus string
+
+const (
+	// TwoFactorEnabled means the user has set up two-factor authentication.
+	TwoFactorEnabled TwoFactorStatus = "enabled"
+
+	// TwoFactorPending means two-factor authentication is enforced for the
+	// user, but has not been set up yet.
+	TwoFactorPending TwoFactorStatus = "pending"
+
+	// TwoFactorDisabled means the user does not use two-factor
+	// authentication.
+	TwoFactorDisabled TwoFactorStatus = "disabled"
+)
+
+// TwoFactorStatus returns the state of two-factor authentication of the user.
+func (u *User) TwoFactorStatus() TwoFactorStatus {
+	switch {
+	case u.TwoFactorAuthEnabled:
+		return TwoFactorEnabled
+	case u.TwoFactorSetupRequired:
+		return TwoFactorPending
+	default:
+		return TwoFactorDisabled
+	}
+}
+
 // usersByLogin is a sortable list of users.
 type usersByName []*User
 
@@ -138,6 +174,21 @@ type UpdateUserInput struct {
 
 	Name *string `url:"name,omitempty"`
 	Role *string `url:"role,omitempty"`
+
+	// Locked prevents the user from logging in, see LockUser.
+	Locked *bool `url:"locked,omitempty"`
+
+	// RequireNewPassword requires the user to change their password on their
+	// next login.
+	RequireNewPassword *bool `url:"require_new_password,omitempty"`
+
+	// OTP is the current one-time password of a user with two-factor
+	// authentication.
+	OTP string `url:"-"`
+
+	// Sudo, if set, elevates the client's token with Sudo before the
+	// request.
+	Sudo *SudoInput `url:"-"`
 }
 
 // UpdateUser updates the user with the given input.
@@ -147,7 +198,7 @@ func (c *Client) UpdateUser(i *UpdateUserInput) (*User, error) {
 	}
 
 	path := fmt.Sprintf("/user/%s", i.ID)
-	resp, err := c.PutForm(path, i, nil)
+	resp, err := c.PutForm(path, i, &RequestOptions{OTP: i.OTP, Sudo: i.Sudo})
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +210,38 @@ func (c *Client) UpdateUser(i *UpdateUserInput) (*User, error) {
 	return u, nil
 }
 
+// LockUserInput is used as input to the LockUser and UnlockUser functions.
+type LockUserInput struct {
+	ID string
+}
+
+// LockUser prevents the user with the given ID from logging in.
+func (c *Client) LockUser(i *LockUserInput) (*User, error) {
+	return c.UpdateUser(&UpdateUserInput{
+		ID:     i.ID,
+		Locked: Bool(true),
+	})
+}
+
+// UnlockUser allows a locked user to log in again.
+func (c *Client) UnlockUser(i *LockUserInput) (*User, error) {
+	return c.UpdateUser(&UpdateUserInput{
+		ID:     i.ID,
+		Locked: Bool(false),
+	})
+}
+
 // DeleteUserInput is used as input to the DeleteUser function.
 type DeleteUserInput struct {
 	ID string
+
+	// OTP is the current one-time password of a user with two-factor
+	// authentication.
+	OTP string
+
+	// Sudo, if set, elevates the client's token with Sudo before the
+	// request.
+	Sudo *SudoInput
 }
 
 // DeleteUser revokes a specific token by its ID.
@@ -171,7 +251,7 @@ func (c *Client) DeleteUser(i *DeleteUserInput) error {
 	}
 
 	path := fmt.Sprintf("/user/%s", i.ID)
-	resp, err := c.Delete(path, nil)
+	resp, err := c.Delete(path, &RequestOptions{OTP: i.OTP, Sudo: i.Sudo})
 	if err != nil {
 		return err
 	}
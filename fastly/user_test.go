@@ -1,6 +1,9 @@
 package fastly
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -105,6 +108,35 @@ func TestClient_Users(t *testing.T) {
 		t.Errorf("bad role: %q", uu.Role)
 	}
 
+	// Lock
+	record(t, fixtureBase+"lock", func(c *Client) {
+		uu, err = c.LockUser(&LockUserInput{
+			ID: u.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !uu.Locked {
+		t.Errorf("expected user to be locked")
+	}
+	if uu.TwoFactorStatus() != TwoFactorPending {
+		t.Errorf("bad two-factor status: %q", uu.TwoFactorStatus())
+	}
+
+	// Unlock
+	record(t, fixtureBase+"unlock", func(c *Client) {
+		uu, err = c.UnlockUser(&LockUserInput{
+			ID: u.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uu.Locked {
+		t.Errorf("expected user to be unlocked")
+	}
+
 	// Reset Password
 	record(t, fixtureBase+"reset_password", func(c *Client) {
 		err = c.ResetUserPassword(&ResetUserPasswordInput{
@@ -174,6 +206,35 @@ func TestClient_UpdateUser_validation(t *testing.T) {
 	}
 }
 
+func TestClient_LockUser_validation(t *testing.T) {
+	var err error
+	_, err = testClient.LockUser(&LockUserInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UnlockUser(&LockUserInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestUser_TwoFactorStatus(t *testing.T) {
+	for _, tc := range []struct {
+		user User
+		want TwoFactorStatus
+	}{
+		{User{TwoFactorAuthEnabled: true}, TwoFactorEnabled},
+		{User{TwoFactorAuthEnabled: true, TwoFactorSetupRequired: true}, TwoFactorEnabled},
+		{User{TwoFactorSetupRequired: true}, TwoFactorPending},
+		{User{}, TwoFactorDisabled},
+	} {
+		if got := tc.user.TwoFactorStatus(); got != tc.want {
+			t.Errorf("%+v: got %q, want %q", tc.user, got, tc.want)
+		}
+	}
+}
+
 func TestClient_DeleteUser_validation(t *testing.T) {
 	err := testClient.DeleteUser(&DeleteUserInput{
 		ID: "",
@@ -191,3 +252,52 @@ func TestClient_ResetUser_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_DeleteUser_sudo(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" otp="+r.Header.Get(OTPHeader))
+		switch r.URL.Path {
+		case "/sudo":
+			w.Write([]byte(`{"expiry_time":"2022-06-20T09:10:32+00:00"}`))
+		case "/user/abc":
+			if r.Method == http.MethodDelete {
+				w.Write([]byte(`{"status":"ok"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"abc","role":"engineer"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sudo := &SudoInput{Username: "user@example.com", Password: "secret"}
+	u, err := c.UpdateUser(&UpdateUserInput{ID: "abc", Role: String("engineer"), OTP: "654321", Sudo: sudo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Role != "engineer" {
+		t.Errorf("bad role: %q", u.Role)
+	}
+	if err := c.DeleteUser(&DeleteUserInput{ID: "abc", Sudo: sudo}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /sudo otp=",
+		"PUT /user/abc otp=654321",
+		"POST /sudo otp=",
+		"DELETE /user/abc otp=",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests)
+	}
+}
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"sort"
 	"time"
@@ -18,6 +19,10 @@ type VCL struct {
 	CreatedAt *time.Time `mapstructure:"created_at"`
 	UpdatedAt *time.Time `mapstructure:"updated_at"`
 	DeletedAt *time.Time `mapstructure:"deleted_at"`
+
+	// ETag is the entity tag of the VCL when it was fetched with GetVCL or
+	// GetGeneratedVCL, to pass as IfNoneMatch to later calls.
+	ETag string `mapstructure:"-" json:"-"`
 }
 
 // vclsByName is a sortable list of VCLs.
@@ -73,6 +78,11 @@ type GetVCLInput struct {
 
 	// Name is the name of the VCL to fetch.
 	Name string
+
+	// IfNoneMatch, if set, is the ETag of a previously fetched VCL. When it is
+	// unchanged, the call fails with an *HTTPError for which IsNotModified is
+	// true instead of returning the VCL again.
+	IfNoneMatch string
 }
 
 // GetVCL gets the VCL configuration with the given parameters.
@@ -90,7 +100,7 @@ func (c *Client) GetVCL(i *GetVCLInput) (*VCL, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{IfNoneMatch: i.IfNoneMatch})
 	if err != nil {
 		return nil, err
 	}
@@ -99,9 +109,47 @@ func (c *Client) GetVCL(i *GetVCLInput) (*VCL, error) {
 	if err := decodeBodyMap(resp.Body, &vcl); err != nil {
 		return nil, err
 	}
+	vcl.ETag = resp.Header.Get("ETag")
 	return vcl, nil
 }
 
+// DownloadVCLInput is used as input to the DownloadVCL function.
+type DownloadVCLInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// Name is the name of the VCL to download.
+	Name string
+}
+
+// DownloadVCL streams the raw content of the VCL file with the given
+// parameters. Unlike GetVCL the content is not buffered in memory, which makes
+// it suitable for very large files. The caller must close the returned reader.
+func (c *Client) DownloadVCL(i *DownloadVCLInput) (io.ReadCloser, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s/download", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
 // GetGeneratedVCLInput is used as input to the GetGeneratedVCL function.
 type GetGeneratedVCLInput struct {
 	// ServiceID is the ID of the service (required).
@@ -109,9 +157,18 @@ type GetGeneratedVCLInput struct {
 
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
+
+	// IfNoneMatch, if set, is the ETag of a previously fetched generated VCL.
+	// When it is unchanged, the call fails with an *HTTPError for which
+	// IsNotModified is true instead of returning the VCL again.
+	IfNoneMatch string
 }
 
 // GetGeneratedVCL gets the VCL configuration with the given parameters.
+//
+// Unlike DownloadVCL there is no streaming variant: the API only returns the
+// generated VCL as the content field of a JSON object and has no raw download
+// endpoint for it, so the content has to be decoded in full.
 func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -122,7 +179,7 @@ func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/generated_vcl", i.ServiceID, i.ServiceVersion)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{IfNoneMatch: i.IfNoneMatch})
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +188,7 @@ func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
 	if err := decodeBodyMap(resp.Body, &vcl); err != nil {
 		return nil, err
 	}
+	vcl.ETag = resp.Header.Get("ETag")
 	return vcl, nil
 }
 
@@ -157,6 +215,12 @@ func (c *Client) CreateVCL(i *CreateVCLInput) (*VCL, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Content != "" {
+		if err := c.validateVCL(i.ServiceID, i.Name, i.Content); err != nil {
+			return nil, err
+		}
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/vcl", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -199,6 +263,12 @@ func (c *Client) UpdateVCL(i *UpdateVCLInput) (*VCL, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Content != nil && *i.Content != "" {
+		if err := c.validateVCL(i.ServiceID, i.Name, *i.Content); err != nil {
+			return nil, err
+		}
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
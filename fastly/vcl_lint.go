@@ -0,0 +1,154 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VCLDiagnosticSeverity is the severity of a VCLDiagnostic.
+type VCLDiagnosticSeverity string
+
+const (
+	// VCLDiagnosticError is a problem that prevents the VCL from compiling.
+	VCLDiagnosticError VCLDiagnosticSeverity = "error"
+
+	// VCLDiagnosticWarning is a problem that does not prevent the VCL from
+	// compiling.
+	VCLDiagnosticWarning VCLDiagnosticSeverity = "warning"
+)
+
+// VCLDiagnostic is a problem found in a custom VCL by a VCLValidator.
+type VCLDiagnostic struct {
+	// Line and Column locate the problem in the VCL, starting at 1. They are
+	// 0 if the location is unknown.
+	Line   int
+	Column int
+
+	Severity VCLDiagnosticSeverity
+	Message  string
+}
+
+// String returns the diagnostic as "line:column: severity: message".
+func (d *VCLDiagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s", d.Line, d.Column, d.Severity, d.Message)
+}
+
+// VCLValidator checks the content of the custom VCL with the given name and
+// returns the problems found. A non-nil error means the VCL could not be
+// checked at all.
+type VCLValidator func(name, content string) ([]*VCLDiagnostic, error)
+
+// VCLValidationError is returned by CreateVCL and UpdateVCL when the
+// client's VCLValidator reports errors, in which case the VCL is not uploaded.
+type VCLValidationError struct {
+	// Name is the name of the VCL.
+	Name string
+
+	// Diagnostics are all problems reported, including warnings.
+	Diagnostics []*VCLDiagnostic
+}
+
+// Error implements the error interface.
+func (e *VCLValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "VCL %q failed validation", e.Name)
+	for _, d := range e.Diagnostics {
+		if d.Severity == VCLDiagnosticError {
+			fmt.Fprintf(&b, "\n  %s", d)
+		}
+	}
+	return b.String()
+}
+
+// validateVCL runs the client's VCLValidator, if any, on the given VCL of the
+// service serviceID.
+func (c *Client) validateVCL(serviceID, name, content string) error {
+	validator := c.vclValidator
+	if c.vclLint {
+		validator = func(_, content string) ([]*VCLDiagnostic, error) {
+			return c.LintVCL(&LintVCLInput{ServiceID: serviceID, Content: content})
+		}
+	}
+	if validator == nil {
+		return nil
+	}
+
+	diags, err := validator(name, content)
+	if err != nil {
+		return err
+	}
+	for _, d := range diags {
+		if d.Severity == VCLDiagnosticError {
+			return &VCLValidationError{Name: name, Diagnostics: diags}
+		}
+	}
+	return nil
+}
+
+// LintVCLInput is used as input to the LintVCL function.
+type LintVCLInput struct {
+	// ServiceID is the ID of the service whose configuration (such as
+	// backends and dictionaries) the VCL is checked against. If empty, the
+	// VCL is checked on its own.
+	ServiceID string `json:"-"`
+
+	// Content is the VCL to check (required).
+	Content string `json:"vcl"`
+}
+
+// vclLintResponse is the response of the VCL lint endpoints.
+type vclLintResponse struct {
+	Errors   []string `mapstructure:"errors"`
+	Warnings []string `mapstructure:"warnings"`
+}
+
+// LintVCL checks a VCL using Fastly's linter, without uploading it to a
+// service version.
+func (c *Client) LintVCL(i *LintVCLInput) ([]*VCLDiagnostic, error) {
+	if i.Content == "" {
+		return nil, ErrMissingContent
+	}
+
+	path := "/vcl_lint"
+	if i.ServiceID != "" {
+		path = fmt.Sprintf("/service/%s/lint", i.ServiceID)
+	}
+	resp, err := c.PostJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *vclLintResponse
+	if err := decodeBodyMap(resp.Body, &r); err != nil {
+		return nil, err
+	}
+
+	diags := make([]*VCLDiagnostic, 0, len(r.Errors)+len(r.Warnings))
+	for _, msg := range r.Errors {
+		diags = append(diags, ParseVCLDiagnostic(VCLDiagnosticError, msg))
+	}
+	for _, msg := range r.Warnings {
+		diags = append(diags, ParseVCLDiagnostic(VCLDiagnosticWarning, msg))
+	}
+	return diags, nil
+}
+
+// vclPositionRe matches the position Fastly's VCL compiler appends to its
+// messages, e.g. "('input' Line 3 Pos 7)".
+var vclPositionRe = regexp.MustCompile(`Line (\d+) Pos (\d+)`)
+
+// ParseVCLDiagnostic turns a message of Fastly's VCL compiler or linter into
+// a VCLDiagnostic, extracting the line and column the message refers to.
+func ParseVCLDiagnostic(severity VCLDiagnosticSeverity, msg string) *VCLDiagnostic {
+	d := &VCLDiagnostic{
+		Severity: severity,
+		Message:  strings.TrimSpace(msg),
+	}
+	if m := vclPositionRe.FindStringSubmatch(msg); m != nil {
+		d.Line, _ = strconv.Atoi(m[1])
+		d.Column, _ = strconv.Atoi(m[2])
+	}
+	return d
+}
@@ -0,0 +1,161 @@
+package fastly
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_LintVCL(t *testing.T) {
+	t.Parallel()
+
+	var path string
+	var body map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"errors":["Unknown variable 'req.foo'\nAt: ('input' Line 3 Pos 7)"],"warnings":["Unused sub"]}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags, err := c.LintVCL(&LintVCLInput{
+		ServiceID: "service-id",
+		Content:   "sub vcl_recv {}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/service/service-id/lint" {
+		t.Errorf("bad path: %q", path)
+	}
+	if body["vcl"] != "sub vcl_recv {}" {
+		t.Errorf("bad body: %v", body)
+	}
+
+	want := []*VCLDiagnostic{
+		{Line: 3, Column: 7, Severity: VCLDiagnosticError, Message: "Unknown variable 'req.foo'\nAt: ('input' Line 3 Pos 7)"},
+		{Severity: VCLDiagnosticWarning, Message: "Unused sub"},
+	}
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("bad diagnostics: got %v, want %v", diags, want)
+	}
+}
+
+func TestClient_CreateVCL_validator(t *testing.T) {
+	t.Parallel()
+
+	var uploads int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		w.Write([]byte(`{"name":"main","content":"ok"}`))
+	}))
+	defer ts.Close()
+
+	validator := func(name, content string) ([]*VCLDiagnostic, error) {
+		if content == "broken" {
+			return []*VCLDiagnostic{
+				{Line: 1, Column: 1, Severity: VCLDiagnosticError, Message: "syntax error"},
+			}, nil
+		}
+		return []*VCLDiagnostic{{Severity: VCLDiagnosticWarning, Message: "style"}}, nil
+	}
+	c, err := NewClient("", WithEndpoint(ts.URL), WithVCLValidator(validator))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.CreateVCL(&CreateVCLInput{
+		ServiceID:      "service-id",
+		ServiceVersion: 1,
+		Name:           "main",
+		Content:        "broken",
+	})
+	var verr *VCLValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a *VCLValidationError, got %v", err)
+	}
+	if verr.Name != "main" || len(verr.Diagnostics) != 1 || verr.Diagnostics[0].Line != 1 {
+		t.Errorf("bad error: %+v", verr)
+	}
+	if uploads != 0 {
+		t.Errorf("broken VCL was uploaded")
+	}
+
+	// Warnings do not prevent the upload.
+	content := "ok"
+	if _, err := c.UpdateVCL(&UpdateVCLInput{
+		ServiceID:      "service-id",
+		ServiceVersion: 1,
+		Name:           "main",
+		Content:        &content,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if uploads != 1 {
+		t.Errorf("expected 1 upload, got %d", uploads)
+	}
+}
+
+func TestClient_CreateVCL_lint(t *testing.T) {
+	t.Parallel()
+
+	var lints, uploads int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/service-id/lint":
+			lints++
+			w.Write([]byte(`{"errors":[],"warnings":[]}`))
+		case "/service/service-id/version/1/vcl", "/service/service-id/version/1/vcl/main":
+			uploads++
+			w.Write([]byte(`{"name":"main","content":"ok"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithVCLLint())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateVCL(&CreateVCLInput{
+		ServiceID:      "service-id",
+		ServiceVersion: 1,
+		Name:           "main",
+		Content:        "sub vcl_recv { set req.backend = F_origin; }",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	content := "ok"
+	if _, err := c.UpdateVCL(&UpdateVCLInput{
+		ServiceID:      "service-id",
+		ServiceVersion: 1,
+		Name:           "main",
+		Content:        &content,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if lints != 2 || uploads != 2 {
+		t.Errorf("expected 2 service lints and 2 uploads, got %d and %d", lints, uploads)
+	}
+}
+
+func TestClient_LintVCL_validation(t *testing.T) {
+	var err error
+	_, err = testClient.LintVCL(&LintVCLInput{})
+	if err != ErrMissingContent {
+		t.Errorf("bad error: %s", err)
+	}
+}
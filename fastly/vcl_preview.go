@@ -0,0 +1,185 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// snippetTypeOrder is the order in which snippet types appear in the
+// rendered VCL, following the request lifecycle.
+var snippetTypeOrder = []SnippetType{
+	SnippetTypeInit, SnippetTypeRecv, SnippetTypeHash, SnippetTypeHit,
+	SnippetTypeMiss, SnippetTypePass, SnippetTypeFetch, SnippetTypeError,
+	SnippetTypeDeliver, SnippetTypeLog, SnippetTypeNone,
+}
+
+// vclIncludeRe matches the include statements of a custom VCL.
+var vclIncludeRe = regexp.MustCompile(`(?m)^\s*include\s+"([^"]+)"\s*;`)
+
+// VCLPreview is the VCL of a service version as it will be compiled: its
+// custom VCLs, the snippets in the order they are rendered, and the VCL
+// generated by Fastly.
+type VCLPreview struct {
+	// Main is the main custom VCL, or nil if the version has none.
+	Main *VCL
+
+	// VCLs are all of the custom VCLs of the version, by name.
+	VCLs map[string]*VCL
+
+	// Includes maps the name of each custom VCL to the names of the custom
+	// VCLs it includes, in the order they are included.
+	Includes map[string][]string
+
+	// Snippets are the snippets of each type, ordered by priority and then
+	// by name, which is the order they are rendered in. Dynamic snippets
+	// have no content, see GetDynamicSnippet.
+	Snippets map[SnippetType][]*Snippet
+
+	// Generated is the VCL generated by Fastly for the version.
+	Generated *VCL
+}
+
+// GetVCLPreviewInput is used as input to the GetVCLPreview function.
+type GetVCLPreviewInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// GetVCLPreview fetches the custom VCLs, snippets and generated VCL of a
+// service version, so that what will run can be reviewed before the version
+// is activated.
+func (c *Client) GetVCLPreview(i *GetVCLPreviewInput) (*VCLPreview, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	vcls, err := c.ListVCLs(&ListVCLsInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snippets, err := c.ListSnippets(&ListSnippetsInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	generated, err := c.GetGeneratedVCL(&GetGeneratedVCLInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &VCLPreview{
+		VCLs:      make(map[string]*VCL, len(vcls)),
+		Includes:  make(map[string][]string, len(vcls)),
+		Snippets:  make(map[SnippetType][]*Snippet),
+		Generated: generated,
+	}
+	for _, v := range vcls {
+		p.VCLs[v.Name] = v
+		if v.Main {
+			p.Main = v
+		}
+		for _, m := range vclIncludeRe.FindAllStringSubmatch(v.Content, -1) {
+			p.Includes[v.Name] = append(p.Includes[v.Name], m[1])
+		}
+	}
+	for _, s := range snippets {
+		p.Snippets[s.Type] = append(p.Snippets[s.Type], s)
+	}
+	for _, ss := range p.Snippets {
+		sort.SliceStable(ss, func(a, b int) bool {
+			if ss[a].Priority != ss[b].Priority {
+				return ss[a].Priority < ss[b].Priority
+			}
+			return ss[a].Name < ss[b].Name
+		})
+	}
+
+	return p, nil
+}
+
+// String renders a summary of the preview: the include tree of the main
+// custom VCL, custom VCLs that are not included anywhere, and the snippets of
+// each type in rendering order.
+func (p *VCLPreview) String() string {
+	var b strings.Builder
+
+	seen := make(map[string]bool)
+	var walk func(name string, depth int)
+	walk = func(name string, depth int) {
+		indent := strings.Repeat("  ", depth)
+		if _, ok := p.VCLs[name]; !ok {
+			fmt.Fprintf(&b, "%s%s (missing)\n", indent, name)
+			return
+		}
+		if seen[name] {
+			fmt.Fprintf(&b, "%s%s (cycle)\n", indent, name)
+			return
+		}
+		fmt.Fprintf(&b, "%s%s\n", indent, name)
+		seen[name] = true
+		for _, inc := range p.Includes[name] {
+			walk(inc, depth+1)
+		}
+		seen[name] = false
+	}
+
+	if p.Main != nil {
+		b.WriteString("main VCL:\n")
+		walk(p.Main.Name, 1)
+	} else {
+		b.WriteString("main VCL: none, generated VCL only\n")
+	}
+
+	included := make(map[string]bool)
+	for _, incs := range p.Includes {
+		for _, inc := range incs {
+			included[inc] = true
+		}
+	}
+	var unused []string
+	for name, v := range p.VCLs {
+		if !v.Main && !included[name] {
+			unused = append(unused, name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		fmt.Fprintf(&b, "not included: %s\n", strings.Join(unused, ", "))
+	}
+
+	for _, t := range snippetTypeOrder {
+		ss := p.Snippets[t]
+		if len(ss) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "snippets %s:\n", t)
+		for _, s := range ss {
+			dynamic := ""
+			if s.Dynamic == 1 {
+				dynamic = " (dynamic)"
+			}
+			fmt.Fprintf(&b, "  %d %s%s\n", s.Priority, s.Name, dynamic)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetVCLPreview(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var p *VCLPreview
+	record(t, "vcl_preview/get", func(c *Client) {
+		p, err = c.GetVCLPreview(&GetVCLPreviewInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: 3,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Main == nil || p.Main.Name != "main" {
+		t.Fatalf("bad main: %v", p.Main)
+	}
+	if len(p.VCLs) != 4 {
+		t.Errorf("bad vcls: %v", p.VCLs)
+	}
+	if got, want := p.Includes["main"], []string{"backends", "routing"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bad includes: got %v, want %v", got, want)
+	}
+	var recv []string
+	for _, s := range p.Snippets[SnippetTypeRecv] {
+		recv = append(recv, s.Name)
+	}
+	if want := []string{"first", "alpha", "zeta"}; !reflect.DeepEqual(recv, want) {
+		t.Errorf("bad recv snippets: got %v, want %v", recv, want)
+	}
+	if p.Generated == nil || p.Generated.Content != "vcl 4.0;\n" {
+		t.Errorf("bad generated: %v", p.Generated)
+	}
+
+	want := `main VCL:
+  main
+    backends
+    routing
+      backends
+not included: unused
+snippets recv:
+  10 first (dynamic)
+  100 alpha
+  100 zeta
+snippets deliver:
+  100 logger
+`
+	if got := p.String(); got != want {
+		t.Errorf("bad render:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestClient_GetVCLPreview_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetVCLPreview(&GetVCLPreviewInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVCLPreview(&GetVCLPreviewInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -45,6 +45,17 @@ const (
 // SnippetType is the type of VCL Snippet
 type SnippetType string
 
+// valid reports whether t is a known snippet type.
+func (t SnippetType) valid() bool {
+	switch t {
+	case SnippetTypeInit, SnippetTypeRecv, SnippetTypeHash, SnippetTypeHit,
+		SnippetTypeMiss, SnippetTypePass, SnippetTypeFetch, SnippetTypeError,
+		SnippetTypeDeliver, SnippetTypeLog, SnippetTypeNone:
+		return true
+	}
+	return false
+}
+
 // Helper function to get a pointer to string
 func SnippetTypeToString(b string) *SnippetType {
 	p := SnippetType(b)
@@ -115,6 +126,10 @@ func (c *Client) CreateSnippet(i *CreateSnippetInput) (*Snippet, error) {
 		return nil, ErrMissingType
 	}
 
+	if !i.Type.valid() {
+		return nil, ErrInvalidSnippetType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/snippet", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -165,6 +180,10 @@ func (c *Client) UpdateSnippet(i *UpdateSnippetInput) (*Snippet, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Type != nil && !i.Type.valid() {
+		return nil, ErrInvalidSnippetType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/snippet/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -379,3 +398,92 @@ func (c *Client) GetDynamicSnippet(i *GetDynamicSnippetInput) (*DynamicSnippet,
 	}
 	return snippet, nil
 }
+
+// ReorderSnippetsInput is the input for ReorderSnippets.
+type ReorderSnippetsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the editable configuration version (required).
+	ServiceVersion int
+
+	// Names are the names of the snippets in the order they should execute
+	// (required). Snippets that are not listed keep their priority.
+	Names []string
+
+	// Step is the gap between consecutive priorities. Defaults to 10, which
+	// leaves room to insert snippets later.
+	Step int
+}
+
+// ReorderSnippets assigns ascending priorities to the named snippets, in the
+// given order. All names are checked against the version's snippets before
+// anything is changed, and only snippets whose priority changes are updated.
+// It returns the updated snippets.
+//
+// The reordering is not atomic: each snippet is updated with its own request,
+// so a failure part way through leaves the earlier snippets with their new
+// priorities. In that case the snippets updated so far are returned along
+// with the error.
+func (c *Client) ReorderSnippets(i *ReorderSnippetsInput) ([]*Snippet, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	if len(i.Names) == 0 {
+		return nil, ErrMissingNames
+	}
+
+	step := i.Step
+	if step <= 0 {
+		step = 10
+	}
+	priorities := make(map[string]int, len(i.Names))
+	for n, name := range i.Names {
+		if _, ok := priorities[name]; ok {
+			return nil, ErrInvalidNames
+		}
+		priorities[name] = (n + 1) * step
+	}
+
+	snippets, err := c.ListSnippets(&ListSnippetsInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*Snippet, len(snippets))
+	for _, s := range snippets {
+		byName[s.Name] = s
+	}
+
+	for _, name := range i.Names {
+		if _, ok := byName[name]; !ok {
+			return nil, newInvalidFieldError("Names").Message(fmt.Sprintf("snippet %q not found", name))
+		}
+	}
+
+	var updated []*Snippet
+	for _, name := range i.Names {
+		priority := priorities[name]
+		if byName[name].Priority == priority {
+			continue
+		}
+		s, err := c.UpdateSnippet(&UpdateSnippetInput{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           name,
+			Priority:       &priority,
+		})
+		if err != nil {
+			return updated, err
+		}
+		updated = append(updated, s)
+	}
+	return updated, nil
+}
@@ -1,6 +1,10 @@
 package fastly
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -273,3 +277,137 @@ func Test_Snippets(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClient_ReorderSnippets(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var updated []*Snippet
+	record(t, "vcl_snippets/reorder", func(c *Client) {
+		updated, err = c.ReorderSnippets(&ReorderSnippetsInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: 3,
+			Names:          []string{"b", "a", "c"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "b" already has priority 10 and is left alone.
+	if len(updated) != 2 {
+		t.Fatalf("expected 2 updated snippets, got %d", len(updated))
+	}
+	if updated[0].Name != "a" || updated[0].Priority != 20 {
+		t.Errorf("bad snippet: %+v", updated[0])
+	}
+	if updated[1].Name != "c" || updated[1].Priority != 30 {
+		t.Errorf("bad snippet: %+v", updated[1])
+	}
+
+	record(t, "vcl_snippets/reorder", func(c *Client) {
+		_, err = c.ReorderSnippets(&ReorderSnippetsInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: 3,
+			Names:          []string{"a", "missing"},
+		})
+	})
+	if ferr, ok := err.(*FieldError); !ok || !errors.Is(err, ErrInvalidField) || ferr.Field() != "Names" {
+		t.Errorf("expected an error for an unknown snippet, got %v", err)
+	}
+}
+
+func TestClient_ReorderSnippets_partial(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/foo/version/3/snippet":
+			fmt.Fprint(w, `[{"name":"a","priority":"10"},{"name":"b","priority":"20"}]`)
+		case r.Method == http.MethodPut && r.URL.Path == "/service/foo/version/3/snippet/b":
+			fmt.Fprint(w, `{"name":"b","priority":"10"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := c.ReorderSnippets(&ReorderSnippetsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 3,
+		Names:          []string{"b", "a"},
+	})
+	if herr, ok := err.(*HTTPError); !ok || herr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("bad error: %v", err)
+	}
+	if len(updated) != 1 || updated[0].Name != "b" || updated[0].Priority != 10 {
+		t.Errorf("expected the snippets updated before the failure, got %v", updated)
+	}
+}
+
+func TestClient_CreateSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateSnippet(&CreateSnippetInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Content:        "# content",
+		Type:           "receive",
+	})
+	if err != ErrInvalidSnippetType {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateSnippet(&UpdateSnippetInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           SnippetTypeToString("receive"),
+	})
+	if err != ErrInvalidSnippetType {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ReorderSnippets_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingNames {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Names:          []string{"a", "b", "a"},
+	})
+	if err != ErrInvalidNames {
+		t.Errorf("bad error: %s", err)
+	}
+}
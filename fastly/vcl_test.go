@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"io"
+	"io/ioutil"
 	"testing"
 )
 
@@ -106,6 +108,28 @@ sub vcl_hash {
 		t.Errorf("bad address: %q", vcl.Content)
 	}
 
+	// Download
+	var dvcl []byte
+	record(t, "vcls/download", func(c *Client) {
+		var r io.ReadCloser
+		r, err = c.DownloadVCL(&DownloadVCLInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: tv.Number,
+			Name:           "test-vcl",
+		})
+		if err != nil {
+			return
+		}
+		defer r.Close()
+		dvcl, err = ioutil.ReadAll(r)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(dvcl) != content {
+		t.Errorf("bad content: %q", dvcl)
+	}
+
 	// Update
 	var uvcl *VCL
 	record(t, "vcls/update", func(c *Client) {
@@ -215,6 +239,33 @@ func TestClient_GetVCL_validation(t *testing.T) {
 	}
 }
 
+func TestClient_DownloadVCL_validation(t *testing.T) {
+	var err error
+	_, err = testClient.DownloadVCL(&DownloadVCLInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.DownloadVCL(&DownloadVCLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.DownloadVCL(&DownloadVCLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
 func TestClient_UpdateVCL_validation(t *testing.T) {
 	var err error
 	_, err = testClient.UpdateVCL(&UpdateVCLInput{
@@ -1,7 +1,10 @@
 package fastly
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"sort"
 	"time"
 )
@@ -19,6 +22,26 @@ type Version struct {
 	CreatedAt *time.Time `mapstructure:"created_at"`
 	UpdatedAt *time.Time `mapstructure:"updated_at"`
 	DeletedAt *time.Time `mapstructure:"deleted_at"`
+
+	// Environments lists the environments, other than production, the
+	// version is active in.
+	Environments []*Environment `mapstructure:"environments"`
+
+	// ETag is the entity tag of the version when it was fetched with
+	// GetVersion, to pass as IfNoneMatch to later calls.
+	ETag string `mapstructure:"-"`
+}
+
+// EnvironmentStaging is the name of the staging environment, in which a
+// version can be tested before it is activated in production.
+const EnvironmentStaging = "staging"
+
+// Environment is a pre-production environment of a service, such as
+// EnvironmentStaging.
+type Environment struct {
+	Name          string `mapstructure:"name"`
+	ServiceID     string `mapstructure:"service_id"`
+	ActiveVersion int    `mapstructure:"active_version"`
 }
 
 // versionsByNumber is a sortable list of versions. This is used by the version
@@ -121,6 +144,11 @@ type GetVersionInput struct {
 
 	// SrrviceVersion is the version number to fetch (required).
 	ServiceVersion int
+
+	// IfNoneMatch, if set, is the ETag of a previously fetched version. When
+	// it is unchanged, the call fails with an *HTTPError for which
+	// IsNotModified is true instead of returning the version again.
+	IfNoneMatch string
 }
 
 // GetVersion fetches a version with the given information.
@@ -134,7 +162,7 @@ func (c *Client) GetVersion(i *GetVersionInput) (*Version, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d", i.ServiceID, i.ServiceVersion)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{IfNoneMatch: i.IfNoneMatch})
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +171,7 @@ func (c *Client) GetVersion(i *GetVersionInput) (*Version, error) {
 	if err := decodeBodyMap(resp.Body, &e); err != nil {
 		return nil, err
 	}
+	e.ETag = resp.Header.Get("ETag")
 	return e, nil
 }
 
@@ -188,9 +217,16 @@ type ActivateVersionInput struct {
 
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
+
+	// Environment is the environment to activate the version in, such as
+	// EnvironmentStaging (optional). The version is activated in production
+	// if it is empty.
+	Environment string
 }
 
-// ActivateVersion activates the given version.
+// ActivateVersion activates the given version. A version activated in the
+// staging environment is promoted to production by activating it again
+// without an Environment.
 func (c *Client) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -201,6 +237,9 @@ func (c *Client) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/activate", i.ServiceID, i.ServiceVersion)
+	if i.Environment != "" {
+		path = fmt.Sprintf("%s/%s", path, url.PathEscape(i.Environment))
+	}
 	resp, err := c.Put(path, nil)
 	if err != nil {
 		return nil, err
@@ -220,6 +259,11 @@ type DeactivateVersionInput struct {
 
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
+
+	// Environment is the environment to deactivate the version in, such as
+	// EnvironmentStaging (optional). The version is deactivated in
+	// production if it is empty.
+	Environment string
 }
 
 // DeactivateVersion deactivates the given version.
@@ -233,6 +277,9 @@ func (c *Client) DeactivateVersion(i *DeactivateVersionInput) (*Version, error)
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/deactivate", i.ServiceID, i.ServiceVersion)
+	if i.Environment != "" {
+		path = fmt.Sprintf("%s/%s", path, url.PathEscape(i.Environment))
+	}
 	resp, err := c.Put(path, nil)
 	if err != nil {
 		return nil, err
@@ -346,3 +393,221 @@ func (c *Client) LockVersion(i *LockVersionInput) (*Version, error) {
 	}
 	return e, nil
 }
+
+// baseVersion returns the active version of a service, or nil if none is
+// active, and the version changes should be based on: the active version,
+// falling back to the latest version.
+func (c *Client) baseVersion(serviceID string) (active, base *Version, err error) {
+	list, err := c.ListVersions(&ListVersionsInput{ServiceID: serviceID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(list) < 1 {
+		return nil, nil, fmt.Errorf("service %s has no versions", serviceID)
+	}
+
+	for _, v := range list {
+		if v.Active {
+			return v, v, nil
+		}
+	}
+	return nil, list[len(list)-1], nil
+}
+
+// CloneLatestEditableVersionInput is the input to the
+// CloneLatestEditableVersion function.
+type CloneLatestEditableVersionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// CloneLatestEditableVersion returns a version of the service that can be
+// modified. The active version is used as the base, falling back to the
+// latest version when none is active. If that version is locked or active it
+// is cloned and the clone is returned, otherwise it is returned as-is.
+func (c *Client) CloneLatestEditableVersion(i *CloneLatestEditableVersionInput) (*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	_, base, err := c.baseVersion(i.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !base.Locked && !base.Active {
+		return base, nil
+	}
+
+	return c.CloneVersion(&CloneVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: base.Number,
+	})
+}
+
+// DefaultActivationPollInterval is the default interval between polls used
+// by WaitForVersionActivation.
+const DefaultActivationPollInterval = 2 * time.Second
+
+// DefaultActivationTimeout is the default time WaitForVersionActivation
+// waits for a version to become active.
+const DefaultActivationTimeout = 5 * time.Minute
+
+// ErrActivationTimeout is returned by WaitForVersionActivation when the
+// version did not become active within the timeout.
+var ErrActivationTimeout = errors.New("timed out waiting for version activation")
+
+// WaitForVersionActivationInput is the input to the WaitForVersionActivation
+// function.
+type WaitForVersionActivationInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// PollInterval is the time between checks. Defaults to
+	// DefaultActivationPollInterval.
+	PollInterval time.Duration
+
+	// Timeout is the maximum time to wait. Defaults to
+	// DefaultActivationTimeout.
+	Timeout time.Duration
+
+	// Context, if set, is used for the polling requests, and the wait stops
+	// with its error once it is done.
+	Context context.Context
+}
+
+// WaitForVersionActivation polls the given version until it is reported as
+// active, returning the final state of the version. When the wait ends early,
+// the last state read is returned along with ErrActivationTimeout or the
+// error of the input's Context.
+func (c *Client) WaitForVersionActivation(i *WaitForVersionActivationInput) (*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	interval := i.PollInterval
+	if interval <= 0 {
+		interval = DefaultActivationPollInterval
+	}
+	timeout := i.Timeout
+	if timeout <= 0 {
+		timeout = DefaultActivationTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	ctx := i.Context
+	if ctx == nil {
+		ctx = context.Background()
+	} else {
+		c = c.WithContext(ctx)
+	}
+
+	for {
+		v, err := c.GetVersion(&GetVersionInput{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if v.Active {
+			return v, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return v, ErrActivationTimeout
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return v, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// PromoteVersionInput is the input to the PromoteVersion function.
+type PromoteVersionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// Mutate is called with the number of the cloned version and makes the
+	// changes to be deployed (required).
+	Mutate func(c *Client, serviceVersion int) error
+
+	// Verify is optionally called once the new version is active. If it
+	// returns an error the previous version is reactivated.
+	Verify func(c *Client, v *Version) error
+}
+
+// PromoteVersion clones the active version, applies the changes made by
+// Mutate to the clone, validates and activates it. If activation or Verify
+// fails, the previously active version is reactivated and the original error
+// returned. If no version was active, the latest version is cloned and there
+// is nothing to roll back to.
+func (c *Client) PromoteVersion(i *PromoteVersionInput) (*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.Mutate == nil {
+		return nil, ErrMissingMutate
+	}
+
+	previous, base, err := c.baseVersion(i.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := c.CloneVersion(&CloneVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: base.Number,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.Mutate(c, v.Number); err != nil {
+		return nil, err
+	}
+
+	ok, msg, err := c.ValidateVersion(&ValidateVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: v.Number,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("version %d failed validation: %s", v.Number, msg)
+	}
+
+	active, err := c.ActivateVersion(&ActivateVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: v.Number,
+	})
+	if err == nil && i.Verify != nil {
+		err = i.Verify(c, active)
+	}
+	if err != nil {
+		if previous != nil {
+			if _, rerr := c.ActivateVersion(&ActivateVersionInput{
+				ServiceID:      i.ServiceID,
+				ServiceVersion: previous.Number,
+			}); rerr != nil {
+				return nil, fmt.Errorf("%w (reactivating version %d also failed: %v)", err, previous.Number, rerr)
+			}
+		}
+		return nil, err
+	}
+	return active, nil
+}
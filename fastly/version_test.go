@@ -1,8 +1,17 @@
 package fastly
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestClient_Versions(t *testing.T) {
@@ -204,6 +213,48 @@ func TestClient_ActivateVersion_validation(t *testing.T) {
 	}
 }
 
+func TestClient_Version_staging(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "versions/"
+
+	var err error
+	var v *Version
+	record(t, fixtureBase+"activate_staging", func(c *Client) {
+		v, err = c.ActivateVersion(&ActivateVersionInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: 3,
+			Environment:    EnvironmentStaging,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Active {
+		t.Errorf("version should not be active in production")
+	}
+	if len(v.Environments) != 1 {
+		t.Fatalf("bad environments: %v", v.Environments)
+	}
+	if e := v.Environments[0]; e.Name != EnvironmentStaging || e.ActiveVersion != 3 {
+		t.Errorf("bad environment: %+v", e)
+	}
+
+	record(t, fixtureBase+"deactivate_staging", func(c *Client) {
+		v, err = c.DeactivateVersion(&DeactivateVersionInput{
+			ServiceID:      testServiceID,
+			ServiceVersion: 3,
+			Environment:    EnvironmentStaging,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Environments) != 0 {
+		t.Errorf("bad environments: %v", v.Environments)
+	}
+}
+
 func TestClient_DeactivateVersion_validation(t *testing.T) {
 	var err error
 	_, err = testClient.DeactivateVersion(&DeactivateVersionInput{
@@ -275,3 +326,284 @@ func TestClient_LockVersion_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CloneLatestEditableVersion(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		versions string
+		cloned   bool
+		want     int
+	}{
+		{
+			name:     "clones active",
+			versions: `[{"number":1,"active":false,"locked":true},{"number":2,"active":true,"locked":true},{"number":3,"active":false,"locked":false}]`,
+			cloned:   true,
+			want:     4,
+		},
+		{
+			name:     "reuses unlocked latest",
+			versions: `[{"number":1,"active":false,"locked":false}]`,
+			want:     1,
+		},
+		{
+			name:     "clones locked latest",
+			versions: `[{"number":1,"active":false,"locked":true}]`,
+			cloned:   true,
+			want:     4,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var cloned int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/service/foo/version":
+					fmt.Fprint(w, tc.versions)
+				case r.Method == "PUT":
+					atomic.AddInt32(&cloned, 1)
+					fmt.Fprint(w, `{"number":4,"active":false,"locked":false}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer ts.Close()
+
+			c, err := NewClient("", WithEndpoint(ts.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+			v, err := c.CloneLatestEditableVersion(&CloneLatestEditableVersionInput{ServiceID: "foo"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.Number != tc.want {
+				t.Errorf("bad number: %d", v.Number)
+			}
+			if got := atomic.LoadInt32(&cloned) == 1; got != tc.cloned {
+				t.Errorf("expected cloned=%t, got %t", tc.cloned, got)
+			}
+		})
+	}
+}
+
+func TestClient_WaitForVersionActivation(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		active := atomic.AddInt32(&calls, 1) >= 3
+		fmt.Fprintf(w, `{"number":2,"active":%t}`, active)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := c.WaitForVersionActivation(&WaitForVersionActivationInput{
+		ServiceID:      "foo",
+		ServiceVersion: 2,
+		PollInterval:   time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Active {
+		t.Errorf("expected active version")
+	}
+
+	atomic.StoreInt32(&calls, -100)
+	_, err = c.WaitForVersionActivation(&WaitForVersionActivationInput{
+		ServiceID:      "foo",
+		ServiceVersion: 2,
+		PollInterval:   time.Millisecond,
+		Timeout:        10 * time.Millisecond,
+	})
+	if err != ErrActivationTimeout {
+		t.Errorf("bad error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	v, err = c.WaitForVersionActivation(&WaitForVersionActivationInput{
+		ServiceID:      "foo",
+		ServiceVersion: 2,
+		PollInterval:   time.Hour,
+		Timeout:        2 * time.Hour,
+		Context:        ctx,
+	})
+	if err != context.Canceled {
+		t.Errorf("bad error: %v", err)
+	}
+	if v == nil || v.Active {
+		t.Errorf("expected the inactive version, got %v", v)
+	}
+	if d := time.Since(start); d > time.Minute {
+		t.Errorf("expected the wait to stop on cancellation, took %s", d)
+	}
+}
+
+func TestClient_CloneLatestEditableVersion_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CloneLatestEditableVersion(&CloneLatestEditableVersionInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_WaitForVersionActivation_validation(t *testing.T) {
+	var err error
+	_, err = testClient.WaitForVersionActivation(&WaitForVersionActivationInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.WaitForVersionActivation(&WaitForVersionActivationInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_PromoteVersion(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(valid bool, requests *[]string) *httptest.Server {
+		var mu sync.Mutex
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			*requests = append(*requests, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+
+			switch r.URL.Path {
+			case "/service/foo/version":
+				fmt.Fprint(w, `[{"number":1,"active":false,"locked":true},{"number":2,"active":true,"locked":true}]`)
+			case "/service/foo/version/2/clone":
+				fmt.Fprint(w, `{"number":3}`)
+			case "/service/foo/version/3/validate":
+				if valid {
+					fmt.Fprint(w, `{"status":"ok"}`)
+				} else {
+					fmt.Fprint(w, `{"status":"error","msg":"bad vcl"}`)
+				}
+			case "/service/foo/version/3/activate":
+				fmt.Fprint(w, `{"number":3,"active":true}`)
+			case "/service/foo/version/2/activate":
+				fmt.Fprint(w, `{"number":2,"active":true}`)
+			default:
+				fmt.Fprint(w, `{"status":"ok"}`)
+			}
+		}))
+	}
+
+	mutate := func(c *Client, serviceVersion int) error {
+		_, err := c.CreateDomain(&CreateDomainInput{
+			ServiceID:      "foo",
+			ServiceVersion: serviceVersion,
+			Name:           "www.example.com",
+		})
+		return err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var requests []string
+		ts := newServer(true, &requests)
+		defer ts.Close()
+
+		c, err := NewClient("", WithEndpoint(ts.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := c.PromoteVersion(&PromoteVersionInput{ServiceID: "foo", Mutate: mutate})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Number != 3 || !v.Active {
+			t.Errorf("bad version: %#v", v)
+		}
+
+		want := []string{
+			"GET /service/foo/version",
+			"PUT /service/foo/version/2/clone",
+			"POST /service/foo/version/3/domain",
+			"GET /service/foo/version/3/validate",
+			"PUT /service/foo/version/3/activate",
+		}
+		if !reflect.DeepEqual(requests, want) {
+			t.Errorf("bad requests: %q", requests)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var requests []string
+		ts := newServer(false, &requests)
+		defer ts.Close()
+
+		c, err := NewClient("", WithEndpoint(ts.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.PromoteVersion(&PromoteVersionInput{ServiceID: "foo", Mutate: mutate})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		for _, r := range requests {
+			if r == "PUT /service/foo/version/3/activate" {
+				t.Errorf("invalid version was activated")
+			}
+		}
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		var requests []string
+		ts := newServer(true, &requests)
+		defer ts.Close()
+
+		c, err := NewClient("", WithEndpoint(ts.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyErr := errors.New("health check failed")
+		_, err = c.PromoteVersion(&PromoteVersionInput{
+			ServiceID: "foo",
+			Mutate:    mutate,
+			Verify: func(c *Client, v *Version) error {
+				return verifyErr
+			},
+		})
+		if err != verifyErr {
+			t.Errorf("bad error: %v", err)
+		}
+		if last := requests[len(requests)-1]; last != "PUT /service/foo/version/2/activate" {
+			t.Errorf("expected previous version to be reactivated, last request: %s", last)
+		}
+	})
+}
+
+func TestClient_PromoteVersion_validation(t *testing.T) {
+	var err error
+	_, err = testClient.PromoteVersion(&PromoteVersionInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.PromoteVersion(&PromoteVersionInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingMutate {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -13,11 +13,6 @@ import (
 	"github.com/google/jsonapi"
 )
 
-// WAFConfigurationSet represents information about a configuration_set.
-type WAFConfigurationSet struct {
-	ID string `jsonapi:"primary,configuration_set"`
-}
-
 // WAF  is the information about a firewall object.
 type WAF struct {
 	ID                             string     `jsonapi:"primary,waf_firewall"`
@@ -35,6 +30,8 @@ type WAF struct {
 	ActiveRulesOWASPLogCount       int        `jsonapi:"attr,active_rules_owasp_log_count"`
 	ActiveRulesOWASPBlockCount     int        `jsonapi:"attr,active_rules_owasp_block_count"`
 	ActiveRulesOWASPScoreCount     int        `jsonapi:"attr,active_rules_owasp_score_count"`
+
+	ConfigurationSet *WAFConfigurationSet `jsonapi:"relation,configuration_set,omitempty"`
 }
 
 // WAFResponse an object containing the list of WAF results.
@@ -43,10 +40,6 @@ type WAFResponse struct {
 	Info  infoResponse
 }
 
-// wafType is used for reflection because JSONAPI wants to know what it's
-// decoding into.
-var wafType = reflect.TypeOf(new(WAF))
-
 // ListWAFsInput is used as input to the ListWAFs function.
 type ListWAFsInput struct {
 	// Limit the number of returned firewalls.
@@ -97,33 +90,58 @@ func (c *Client) ListWAFs(i *ListWAFsInput) (*WAFResponse, error) {
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
+	info, body, err := getResponseInfo(resp.Body)
 	if err != nil {
 		return nil, err
 	}
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), wafType)
+	wafs, err := decodeMany[WAF](body)
 	if err != nil {
 		return nil, err
 	}
 
-	wafs := make([]*WAF, len(data))
-	for i := range data {
-		typed, ok := data[i].(*WAF)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-WAF response")
-		}
-		wafs[i] = typed
-	}
-
 	return &WAFResponse{
 		Items: wafs,
 		Info:  info,
 	}, nil
 }
 
+// ListAllWAFsInput is used as input to the ListAllWAFs function.
+type ListAllWAFsInput struct {
+	// Specify the service ID of the returned firewalls.
+	FilterService string
+	// Specify the version of the service for the firewalls.
+	FilterVersion int
+	// Include relationships. Optional, comma-separated values. Permitted values: waf_firewall_versions.
+	Include string
+}
+
+// ListAllWAFs returns the complete list of WAFs for the given filters. It iterates through
+// all existing pages to ensure all WAFs are returned at once.
+func (c *Client) ListAllWAFs(i *ListAllWAFsInput) (*WAFResponse, error) {
+
+	currentPage := 1
+	result := &WAFResponse{Items: []*WAF{}}
+	for {
+		r, err := c.ListWAFs(&ListWAFsInput{
+			FilterService: i.FilterService,
+			FilterVersion: i.FilterVersion,
+			Include:       i.Include,
+			PageNumber:    currentPage,
+			PageSize:      WAFPaginationPageSize,
+		})
+		if err != nil {
+			return r, err
+		}
+
+		currentPage++
+		result.Items = append(result.Items, r.Items...)
+
+		if r.Info.Links.Next == "" || len(r.Items) == 0 {
+			return result, nil
+		}
+	}
+}
+
 // CreateWAFInput is used as input to the CreateWAF function.
 type CreateWAFInput struct {
 	ID                string `jsonapi:"primary,waf_firewall"`
@@ -295,17 +313,18 @@ type metaInfo struct {
 	TotalPages  int `json:"total_pages,omitempty"`
 }
 
-// getResponseInfo parses a response to get the pagination and metadata info.
-func getResponseInfo(body io.Reader) (infoResponse, error) {
-
+// getResponseInfo reads a JSON:API list response, returning its pagination and
+// metadata info together with a reader over the response, so that the data
+// can be decoded without reading or buffering the response a second time.
+func getResponseInfo(body io.Reader) (infoResponse, io.Reader, error) {
 	bodyBytes, err := ioutil.ReadAll(body)
 	if err != nil {
-		return infoResponse{}, err
+		return infoResponse{}, nil, err
 	}
 
 	var info infoResponse
 	if err := json.Unmarshal(bodyBytes, &info); err != nil {
-		return infoResponse{}, err
+		return infoResponse{}, nil, err
 	}
-	return info, nil
+	return info, bytes.NewReader(bodyBytes), nil
 }
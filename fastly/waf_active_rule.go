@@ -1,30 +1,55 @@
 package fastly
 
 import (
-	"bytes"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"time"
-
-	"github.com/google/jsonapi"
 )
 
 // WAFActiveRuleType is used for reflection because JSONAPI wants to know what it's
 // decoding into.
 var WAFActiveRuleType = reflect.TypeOf(new(WAFActiveRule))
 
+const (
+	// WAFActiveRuleStatusLog logs requests matching the rule.
+	WAFActiveRuleStatusLog WAFActiveRuleStatus = "log"
+
+	// WAFActiveRuleStatusBlock blocks requests matching the rule.
+	WAFActiveRuleStatusBlock WAFActiveRuleStatus = "block"
+
+	// WAFActiveRuleStatusScore adds to the anomaly score of requests matching
+	// the rule.
+	WAFActiveRuleStatusScore WAFActiveRuleStatus = "score"
+)
+
+// WAFActiveRuleStatus is the action taken by the WAF for an active rule.
+type WAFActiveRuleStatus string
+
+// valid reports whether s is a known active rule status.
+func (s WAFActiveRuleStatus) valid() bool {
+	switch s {
+	case WAFActiveRuleStatusLog, WAFActiveRuleStatusBlock, WAFActiveRuleStatusScore:
+		return true
+	}
+	return false
+}
+
 // WAFActiveRule is the information about a WAF active rule object.
 type WAFActiveRule struct {
-	ID             string     `jsonapi:"primary,waf_active_rule,omitempty"`
-	Status         string     `jsonapi:"attr,status,omitempty"`
-	ModSecID       int        `jsonapi:"attr,modsec_rule_id,omitempty"`
-	Revision       int        `jsonapi:"attr,revision,omitempty"`
-	Outdated       bool       `jsonapi:"attr,outdated,omitempty"`
-	LatestRevision int        `jsonapi:"attr,latest_revision,omitempty"`
-	CreatedAt      *time.Time `jsonapi:"attr,created_at,iso8601,omitempty"`
-	UpdatedAt      *time.Time `jsonapi:"attr,updated_at,iso8601,omitempty"`
+	ID             string              `jsonapi:"primary,waf_active_rule,omitempty"`
+	Status         WAFActiveRuleStatus `jsonapi:"attr,status,omitempty"`
+	ModSecID       int                 `jsonapi:"attr,modsec_rule_id,omitempty"`
+	Revision       int                 `jsonapi:"attr,revision,omitempty"`
+	Outdated       bool                `jsonapi:"attr,outdated,omitempty"`
+	LatestRevision int                 `jsonapi:"attr,latest_revision,omitempty"`
+	CreatedAt      *time.Time          `jsonapi:"attr,created_at,iso8601,omitempty"`
+	UpdatedAt      *time.Time          `jsonapi:"attr,updated_at,iso8601,omitempty"`
+
+	// RuleRevision is the revision of the rule, with its message, severity
+	// and VCL. It is only set when the active rules are listed with
+	// Include set to "waf_rule_revision".
+	RuleRevision *WAFRuleRevision `jsonapi:"relation,waf_rule_revision,omitempty"`
 }
 
 // WAFActiveRuleResponse represents a list of active rules - full response.
@@ -99,27 +124,15 @@ func (c *Client) ListWAFActiveRules(i *ListWAFActiveRulesInput) (*WAFActiveRuleR
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
+	info, body, err := getResponseInfo(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFActiveRuleType)
+	wafRules, err := decodeMany[WAFActiveRule](body)
 	if err != nil {
 		return nil, err
 	}
-
-	wafRules := make([]*WAFActiveRule, len(data))
-	for i := range data {
-		typed, ok := data[i].(*WAFActiveRule)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-WAFActiveRule response")
-		}
-		wafRules[i] = typed
-	}
 	return &WAFActiveRuleResponse{
 		Items: wafRules,
 		Info:  info,
@@ -205,26 +218,23 @@ func (c *Client) CreateWAFActiveRules(i *CreateWAFActiveRulesInput) ([]*WAFActiv
 		return nil, ErrMissingWAFActiveRule
 	}
 
+	for _, r := range i.Rules {
+		if !r.Status.valid() {
+			return nil, ErrInvalidWAFActiveRuleStatus
+		}
+	}
+
 	path := fmt.Sprintf("/waf/firewalls/%s/versions/%d/active-rules", i.WAFID, i.WAFVersionNumber)
 	resp, err := c.PostJSONAPIBulk(path, i.Rules, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(resp.Body, WAFActiveRuleType)
+	wafRules, err := decodeMany[WAFActiveRule](resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	wafRules := make([]*WAFActiveRule, len(data))
-	for i := range data {
-		typed, ok := data[i].(*WAFActiveRule)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-WAFActiveRule response")
-		}
-		wafRules[i] = typed
-	}
-
 	return wafRules, nil
 }
 
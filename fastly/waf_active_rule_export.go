@@ -0,0 +1,180 @@
+package fastly
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// wafActiveRuleColumns are the CSV columns written by WriteCSV and read by
+// ReadWAFActiveRulesCSV, named after the API attributes.
+var wafActiveRuleColumns = []string{"modsec_rule_id", "revision", "status"}
+
+// wafActiveRuleExport is the JSON representation of an exported active rule.
+type wafActiveRuleExport struct {
+	ModSecID int                 `json:"modsec_rule_id"`
+	Revision int                 `json:"revision"`
+	Status   WAFActiveRuleStatus `json:"status"`
+}
+
+// WriteCSV writes the active rules as CSV to w: a header row with the columns
+// modsec_rule_id, revision and status, then one row per rule. The result can
+// be read back with ReadWAFActiveRulesCSV.
+func (r *WAFActiveRuleResponse) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(wafActiveRuleColumns); err != nil {
+		return err
+	}
+	for _, rule := range r.Items {
+		if err := cw.Write([]string{
+			strconv.Itoa(rule.ModSecID),
+			strconv.Itoa(rule.Revision),
+			string(rule.Status),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the active rules as a JSON array to w, with one object per
+// rule holding its modsec_rule_id, revision and status. The result can be read
+// back with ReadWAFActiveRulesJSON.
+func (r *WAFActiveRuleResponse) WriteJSON(w io.Writer) error {
+	rules := make([]wafActiveRuleExport, len(r.Items))
+	for i, rule := range r.Items {
+		rules[i] = wafActiveRuleExport{
+			ModSecID: rule.ModSecID,
+			Revision: rule.Revision,
+			Status:   rule.Status,
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rules)
+}
+
+// ReadWAFActiveRulesCSV reads active rules written by
+// WAFActiveRuleResponse.WriteCSV. The header row must contain the
+// modsec_rule_id, revision and status columns, in any order; other columns
+// are ignored.
+func ReadWAFActiveRulesCSV(r io.Reader) ([]*WAFActiveRule, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(wafActiveRuleColumns))
+	for i, name := range header {
+		index[name] = i
+	}
+	for _, name := range wafActiveRuleColumns {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	var rules []*WAFActiveRule
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return rules, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+
+		id, err := strconv.Atoi(record[index["modsec_rule_id"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid modsec_rule_id: %w", line, err)
+		}
+		revision, err := strconv.Atoi(record[index["revision"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid revision: %w", line, err)
+		}
+		status := WAFActiveRuleStatus(record[index["status"]])
+		if !status.valid() {
+			return nil, fmt.Errorf("line %d: %w", line, ErrInvalidWAFActiveRuleStatus)
+		}
+
+		rules = append(rules, &WAFActiveRule{
+			ModSecID: id,
+			Revision: revision,
+			Status:   status,
+		})
+	}
+}
+
+// ReadWAFActiveRulesJSON reads active rules written by
+// WAFActiveRuleResponse.WriteJSON.
+func ReadWAFActiveRulesJSON(r io.Reader) ([]*WAFActiveRule, error) {
+	var exported []wafActiveRuleExport
+	if err := json.NewDecoder(r).Decode(&exported); err != nil {
+		return nil, err
+	}
+
+	rules := make([]*WAFActiveRule, len(exported))
+	for i, e := range exported {
+		if !e.Status.valid() {
+			return nil, fmt.Errorf("rule %d: %w", e.ModSecID, ErrInvalidWAFActiveRuleStatus)
+		}
+		rules[i] = &WAFActiveRule{
+			ModSecID: e.ModSecID,
+			Revision: e.Revision,
+			Status:   e.Status,
+		}
+	}
+	return rules, nil
+}
+
+// ImportWAFActiveRulesInput is used as input to the ImportWAFActiveRules
+// function.
+type ImportWAFActiveRulesInput struct {
+	// The Web Application Firewall's ID.
+	WAFID string
+	// The Web Application Firewall's version number.
+	WAFVersionNumber int
+	// The list of WAF active rules, as read by ReadWAFActiveRulesCSV or
+	// ReadWAFActiveRulesJSON (ModSecID, Status and Revision are required).
+	Rules []*WAFActiveRule
+}
+
+// ImportWAFActiveRules adds previously exported active rules to a WAF
+// version, e.g. to promote the rules of a staging firewall to production.
+// Existing active rules with the same ModSecID are updated. The rules are
+// created in chunks of BatchModifyMaximumOperations, so a failure part way
+// through can leave the version partially imported; the rules created before
+// the failure are returned along with the error.
+func (c *Client) ImportWAFActiveRules(i *ImportWAFActiveRulesInput) ([]*WAFActiveRule, error) {
+	if i.WAFID == "" {
+		return nil, ErrMissingWAFID
+	}
+
+	if i.WAFVersionNumber == 0 {
+		return nil, ErrMissingWAFVersionNumber
+	}
+
+	if len(i.Rules) == 0 {
+		return nil, ErrMissingWAFActiveRule
+	}
+
+	var created []*WAFActiveRule
+	for _, rules := range chunk(i.Rules, BatchModifyMaximumOperations) {
+		rs, err := c.CreateWAFActiveRules(&CreateWAFActiveRulesInput{
+			WAFID:            i.WAFID,
+			WAFVersionNumber: i.WAFVersionNumber,
+			Rules:            rules,
+		})
+		if err != nil {
+			return created, err
+		}
+		created = append(created, rs...)
+	}
+
+	return created, nil
+}
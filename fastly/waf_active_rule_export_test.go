@@ -0,0 +1,184 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/jsonapi"
+)
+
+func TestWAFActiveRuleResponse_export(t *testing.T) {
+	t.Parallel()
+
+	r := &WAFActiveRuleResponse{Items: []*WAFActiveRule{
+		{ID: "a", ModSecID: 2029718, Revision: 1, Status: WAFActiveRuleStatusLog, LatestRevision: 2},
+		{ID: "b", ModSecID: 2037405, Revision: 3, Status: WAFActiveRuleStatusBlock},
+	}}
+	want := []*WAFActiveRule{
+		{ModSecID: 2029718, Revision: 1, Status: WAFActiveRuleStatusLog},
+		{ModSecID: 2037405, Revision: 3, Status: WAFActiveRuleStatusBlock},
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := buf.String(), "modsec_rule_id,revision,status\n2029718,1,log\n2037405,3,block\n"; got != expected {
+		t.Errorf("bad CSV: %q", got)
+	}
+	rules, err := ReadWAFActiveRulesCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("bad CSV rules: %v", rules)
+	}
+
+	buf.Reset()
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	rules, err = ReadWAFActiveRulesJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("bad JSON rules: %v", rules)
+	}
+}
+
+func TestReadWAFActiveRulesCSV_validation(t *testing.T) {
+	t.Parallel()
+
+	rules, err := ReadWAFActiveRulesCSV(strings.NewReader("status,comment,revision,modsec_rule_id\nscore,x,2,1010090\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0].ModSecID != 1010090 || rules[0].Revision != 2 || rules[0].Status != WAFActiveRuleStatusScore {
+		t.Errorf("bad rules: %v", rules)
+	}
+
+	_, err = ReadWAFActiveRulesCSV(strings.NewReader("modsec_rule_id,status\n1010090,log\n"))
+	if err == nil || !strings.Contains(err.Error(), `"revision"`) {
+		t.Errorf("expected missing column error, got %v", err)
+	}
+
+	_, err = ReadWAFActiveRulesCSV(strings.NewReader("modsec_rule_id,revision,status\n1010090,1,log\n1010091,1,drop\n"))
+	if !errors.Is(err, ErrInvalidWAFActiveRuleStatus) || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("expected invalid status error, got %v", err)
+	}
+
+	_, err = ReadWAFActiveRulesJSON(strings.NewReader(`[{"modsec_rule_id":1010090,"revision":1,"status":"drop"}]`))
+	if !errors.Is(err, ErrInvalidWAFActiveRuleStatus) {
+		t.Errorf("expected invalid status error, got %v", err)
+	}
+}
+
+func TestClient_ImportWAFActiveRules(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Method != http.MethodPost || r.URL.Path != "/waf/firewalls/fw/versions/2/active-rules" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var payload struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(payload.Data) > BatchModifyMaximumOperations {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", jsonapi.MediaType)
+		json.NewEncoder(w).Encode(payload)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := make([]*WAFActiveRule, BatchModifyMaximumOperations+1)
+	for i := range rules {
+		rules[i] = &WAFActiveRule{ModSecID: 1000000 + i, Revision: 1, Status: WAFActiveRuleStatusLog}
+	}
+	created, err := c.ImportWAFActiveRules(&ImportWAFActiveRulesInput{
+		WAFID:            "fw",
+		WAFVersionNumber: 2,
+		Rules:            rules,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != len(rules) {
+		t.Errorf("expected %d rules, got %d", len(rules), len(created))
+	}
+	if created[len(created)-1].ModSecID != 1000000+BatchModifyMaximumOperations {
+		t.Errorf("bad last rule: %v", created[len(created)-1])
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("expected 2 calls, got %d", n)
+	}
+
+	_, err = c.ImportWAFActiveRules(&ImportWAFActiveRulesInput{WAFID: "fw", WAFVersionNumber: 2})
+	if err != ErrMissingWAFActiveRule {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ImportWAFActiveRules_partial(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var payload struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", jsonapi.MediaType)
+		json.NewEncoder(w).Encode(payload)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := make([]*WAFActiveRule, BatchModifyMaximumOperations+1)
+	for i := range rules {
+		rules[i] = &WAFActiveRule{ModSecID: 1000000 + i, Revision: 1, Status: WAFActiveRuleStatusLog}
+	}
+	created, err := c.ImportWAFActiveRules(&ImportWAFActiveRulesInput{
+		WAFID:            "fw",
+		WAFVersionNumber: 2,
+		Rules:            rules,
+	})
+	if herr, ok := err.(*HTTPError); !ok || herr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("bad error: %v", err)
+	}
+	if len(created) != BatchModifyMaximumOperations {
+		t.Errorf("expected the %d rules of the first chunk, got %d", BatchModifyMaximumOperations, len(created))
+	}
+}
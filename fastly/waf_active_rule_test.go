@@ -207,6 +207,15 @@ func TestClient_CreateWAFActiveRules_validation(t *testing.T) {
 	if err != ErrMissingWAFActiveRule {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateWAFActiveRules(&CreateWAFActiveRulesInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+		Rules:            buildWAFRules("disabled"),
+	})
+	if err != ErrInvalidWAFActiveRuleStatus {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_BatchModificationWAFActiveRules_validation(t *testing.T) {
@@ -258,7 +267,7 @@ func TestClient_DeleteWAFActiveRules_validation(t *testing.T) {
 	}
 }
 
-func buildWAFRules(status string) []*WAFActiveRule {
+func buildWAFRules(status WAFActiveRuleStatus) []*WAFActiveRule {
 	return []*WAFActiveRule{
 		{
 			ModSecID: 2029718,
@@ -277,3 +286,30 @@ func buildWAFRules(status string) []*WAFActiveRule {
 		},
 	}
 }
+
+func TestClient_ListWAFActiveRules_includeRuleRevision(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var rulesResp *WAFActiveRuleResponse
+	record(t, "waf_active_rules/list_include_revision", func(c *Client) {
+		rulesResp, err = c.ListWAFActiveRules(&ListWAFActiveRulesInput{
+			WAFID:            "3kO0SWvY3tX7kFauSbqyDk",
+			WAFVersionNumber: 1,
+			Include:          "waf_rule_revision",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rulesResp.Items) != 2 {
+		t.Fatalf("expected 2 active rules, got %d", len(rulesResp.Items))
+	}
+	r := rulesResp.Items[1]
+	if r.RuleRevision == nil {
+		t.Fatal("expected the rule revision to be included")
+	}
+	if r.RuleRevision.ModSecID != r.ModSecID || r.RuleRevision.Severity != 2 || r.RuleRevision.Status != "Request Content-Type is not allowed by policy" {
+		t.Errorf("bad rule revision: %#v", r.RuleRevision)
+	}
+}
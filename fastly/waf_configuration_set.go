@@ -0,0 +1,116 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// WAFConfigurationSet represents information about a configuration_set.
+type WAFConfigurationSet struct {
+	ID     string `jsonapi:"primary,configuration_set"`
+	Name   string `jsonapi:"attr,name,omitempty"`
+	Active bool   `jsonapi:"attr,active,omitempty"`
+}
+
+// WAFConfigurationSetResponse represents a list of configuration sets full response.
+type WAFConfigurationSetResponse struct {
+	Items []*WAFConfigurationSet
+	Info  infoResponse
+}
+
+// ListConfigurationSetsInput is used as input to the ListConfigurationSets function.
+type ListConfigurationSetsInput struct {
+	// Limit the number of returned configuration sets.
+	PageSize int
+	// Request a specific page of configuration sets.
+	PageNumber int
+}
+
+func (i *ListConfigurationSetsInput) formatFilters() map[string]string {
+
+	result := map[string]string{}
+	pairings := map[string]interface{}{
+		"page[size]":   i.PageSize,
+		"page[number]": i.PageNumber,
+	}
+
+	for key, value := range pairings {
+		switch t := reflect.TypeOf(value).String(); t {
+		case "string":
+			if value != "" {
+				result[key] = value.(string)
+			}
+		case "int":
+			if value != 0 {
+				result[key] = strconv.Itoa(value.(int))
+			}
+		}
+	}
+	return result
+}
+
+// ListConfigurationSets returns the list of WAF configuration sets.
+func (c *Client) ListConfigurationSets(i *ListConfigurationSetsInput) (*WAFConfigurationSetResponse, error) {
+
+	resp, err := c.Get("/wafs/configuration_sets", &RequestOptions{
+		Params: i.formatFilters(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, body, err := getResponseInfo(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	sets, err := decodeMany[WAFConfigurationSet](body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WAFConfigurationSetResponse{
+		Items: sets,
+		Info:  info,
+	}, nil
+}
+
+// ConfigurationSetWAF identifies a WAF being moved to a configuration set.
+type ConfigurationSetWAF struct {
+	ID string `jsonapi:"primary,waf_firewall"`
+}
+
+// UpdateWAFConfigurationSetInput is used as input to the
+// UpdateWAFConfigurationSet function.
+type UpdateWAFConfigurationSetInput struct {
+	// ConfigurationSetID is the ID of the configuration set the WAFs are
+	// moved to (required).
+	ConfigurationSetID string
+
+	// WAFList is the list of WAFs to move (required).
+	WAFList []*ConfigurationSetWAF
+}
+
+// UpdateWAFConfigurationSet moves the given WAFs to a configuration set.
+func (c *Client) UpdateWAFConfigurationSet(i *UpdateWAFConfigurationSetInput) ([]*ConfigurationSetWAF, error) {
+	if i.ConfigurationSetID == "" {
+		return nil, ErrMissingConfigurationSetID
+	}
+
+	if len(i.WAFList) == 0 {
+		return nil, ErrMissingWAFList
+	}
+
+	path := fmt.Sprintf("/wafs/configuration_sets/%s/relationships/wafs", i.ConfigurationSetID)
+	resp, err := c.PatchJSONAPI(path, i.WAFList, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wafs, err := decodeMany[ConfigurationSetWAF](resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return wafs, nil
+}
@@ -0,0 +1,82 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClient_WAF_Configuration_Sets(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "waf_configuration_sets/"
+
+	var err error
+	var setsResp *WAFConfigurationSetResponse
+	record(t, fixtureBase+"list", func(c *Client) {
+		setsResp, err = c.ListConfigurationSets(&ListConfigurationSetsInput{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(setsResp.Items) != 2 {
+		t.Fatalf("expected 2 configuration sets: got %d", len(setsResp.Items))
+	}
+	if !setsResp.Items[0].Active || setsResp.Items[0].Name != "Fastly WAF 2019 - Active" {
+		t.Errorf("bad configuration set: %#v", setsResp.Items[0])
+	}
+
+	var wafs []*ConfigurationSetWAF
+	record(t, fixtureBase+"update", func(c *Client) {
+		wafs, err = c.UpdateWAFConfigurationSet(&UpdateWAFConfigurationSetInput{
+			ConfigurationSetID: "1ajwvYGYbMNLdmlQlDhyr5",
+			WAFList:            []*ConfigurationSetWAF{{ID: "3Pxlc3CjBd8efnATbW4BzC"}},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wafs) != 1 || wafs[0].ID != "3Pxlc3CjBd8efnATbW4BzC" {
+		t.Errorf("bad wafs: %#v", wafs)
+	}
+}
+
+func TestClient_UpdateWAFConfigurationSet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateWAFConfigurationSet(&UpdateWAFConfigurationSetInput{
+		ConfigurationSetID: "",
+	})
+	if err != ErrMissingConfigurationSetID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateWAFConfigurationSet(&UpdateWAFConfigurationSetInput{
+		ConfigurationSetID: "1",
+	})
+	if err != ErrMissingWAFList {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_listConfigurationSets_formatFilters(t *testing.T) {
+	cases := []struct {
+		remote *ListConfigurationSetsInput
+		local  map[string]string
+	}{
+		{
+			remote: &ListConfigurationSetsInput{
+				PageSize:   2,
+				PageNumber: 2,
+			},
+			local: map[string]string{
+				"page[size]":   "2",
+				"page[number]": "2",
+			},
+		},
+	}
+	for _, c := range cases {
+		out := c.remote.formatFilters()
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\n     got: %#v", c.local, out)
+		}
+	}
+}
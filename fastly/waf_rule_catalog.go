@@ -0,0 +1,118 @@
+package fastly
+
+import (
+	"strings"
+	"sync"
+)
+
+// WAFRuleCatalog is a local copy of the WAF rules catalog, with the tags and
+// revisions of every rule, that can be searched without further requests. It
+// is fetched once by Client.WAFRuleCatalog and only updated by Refresh, which
+// suits interactive tools that look up rules repeatedly. A WAFRuleCatalog is
+// safe for concurrent use.
+type WAFRuleCatalog struct {
+	client *Client
+	filter ListAllWAFRulesInput
+
+	mu    sync.RWMutex
+	rules []*WAFRule
+}
+
+// WAFRuleCatalog fetches the WAF rules matching the filters of i, which may
+// be nil to fetch the whole catalog, and returns them as a WAFRuleCatalog.
+// The Include field of i is ignored; tags and revisions are always included.
+func (c *Client) WAFRuleCatalog(i *ListAllWAFRulesInput) (*WAFRuleCatalog, error) {
+	cat := &WAFRuleCatalog{client: c}
+	if i != nil {
+		cat.filter = *i
+	}
+	cat.filter.Include = "waf_tags,waf_rule_revisions"
+
+	if err := cat.Refresh(); err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// Refresh fetches the rules of the catalog again. The previous rules are kept
+// if fetching fails. The client's cache (see WithCache) is bypassed.
+func (cat *WAFRuleCatalog) Refresh() error {
+	rules := []*WAFRule{}
+	err := cat.client.StreamWAFRules(&cat.filter, func(r *WAFRule) error {
+		rules = append(rules, r)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	cat.mu.Lock()
+	cat.rules = rules
+	cat.mu.Unlock()
+	return nil
+}
+
+// Rules returns all rules of the catalog. The rules are shared with the
+// catalog and must not be modified.
+func (cat *WAFRuleCatalog) Rules() []*WAFRule {
+	cat.mu.RLock()
+	defer cat.mu.RUnlock()
+	return append([]*WAFRule{}, cat.rules...)
+}
+
+// WAFRuleQuery selects rules in WAFRuleCatalog.Search. Empty fields match
+// every rule, set fields must all match.
+type WAFRuleQuery struct {
+	// Message matches rules whose latest revision has a message containing
+	// it, ignoring case.
+	Message string
+	// Tag matches rules with a tag of that name, ignoring case.
+	Tag string
+	// Severity matches rules whose latest revision has that severity.
+	Severity *int
+}
+
+// Search returns the rules of the catalog matching q, in catalog order. The
+// rules are shared with the catalog and must not be modified.
+func (cat *WAFRuleCatalog) Search(q *WAFRuleQuery) []*WAFRule {
+	message := strings.ToLower(q.Message)
+
+	cat.mu.RLock()
+	defer cat.mu.RUnlock()
+
+	var matches []*WAFRule
+	for _, r := range cat.rules {
+		if q.matches(r, message) {
+			matches = append(matches, r)
+		}
+	}
+	return matches
+}
+
+// matches reports whether r is selected by q. message is q.Message in lower
+// case.
+func (q *WAFRuleQuery) matches(r *WAFRule, message string) bool {
+	rev := r.LatestRevision()
+	if message != "" || q.Severity != nil {
+		if rev == nil {
+			return false
+		}
+		// The message of a revision is decoded into its Status field.
+		if !strings.Contains(strings.ToLower(rev.Status), message) {
+			return false
+		}
+		if q.Severity != nil && rev.Severity != *q.Severity {
+			return false
+		}
+	}
+
+	if q.Tag != "" {
+		for _, t := range r.Tags {
+			if strings.EqualFold(t.Name, q.Tag) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/jsonapi"
+)
+
+func TestClient_WAFRuleCatalog(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path != "/waf/rules" || r.URL.Query().Get("include") != "waf_tags,waf_rule_revisions" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", jsonapi.MediaType)
+		fmt.Fprint(w, `{
+			"data": [
+				{"id": "r1", "type": "waf_rule", "attributes": {"modsec_rule_id": 933100},
+				 "relationships": {
+					"waf_tags": {"data": [{"id": "t1", "type": "waf_tag"}, {"id": "t2", "type": "waf_tag"}]},
+					"waf_rule_revisions": {"data": [{"id": "v1", "type": "waf_rule_revision"}, {"id": "v2", "type": "waf_rule_revision"}]}}},
+				{"id": "r2", "type": "waf_rule", "attributes": {"modsec_rule_id": 941100},
+				 "relationships": {
+					"waf_tags": {"data": [{"id": "t2", "type": "waf_tag"}]},
+					"waf_rule_revisions": {"data": [{"id": "v3", "type": "waf_rule_revision"}]}}}
+			],
+			"included": [
+				{"id": "t1", "type": "waf_tag", "attributes": {"name": "language-php"}},
+				{"id": "t2", "type": "waf_tag", "attributes": {"name": "OWASP"}},
+				{"id": "v1", "type": "waf_rule_revision", "attributes": {"message": "PHP Injection Attack", "severity": 4, "revision": 1}},
+				{"id": "v2", "type": "waf_rule_revision", "attributes": {"message": "PHP Injection Attack: Opening Tag Found", "severity": 2, "revision": 2}},
+				{"id": "v3", "type": "waf_rule_revision", "attributes": {"message": "XSS Attack Detected via libinjection", "severity": 2, "revision": 1}}
+			],
+			"links": {},
+			"meta": {"record_count": 2}
+		}`)
+	}))
+	defer ts.Close()
+
+	c, err := NewClient("", WithEndpoint(ts.URL), WithCache(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cat, err := c.WAFRuleCatalog(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(cat.Rules()); n != 2 {
+		t.Fatalf("expected 2 rules, got %d", n)
+	}
+
+	for _, tc := range []struct {
+		name string
+		q    WAFRuleQuery
+		want string
+	}{
+		{name: "all", q: WAFRuleQuery{}, want: "[933100 941100]"},
+		{name: "message", q: WAFRuleQuery{Message: "opening tag"}, want: "[933100]"},
+		{name: "earlier revision", q: WAFRuleQuery{Message: "php injection attack", Severity: Int(4)}, want: "[]"},
+		{name: "tag", q: WAFRuleQuery{Tag: "owasp"}, want: "[933100 941100]"},
+		{name: "severity", q: WAFRuleQuery{Severity: Int(2)}, want: "[933100 941100]"},
+		{name: "combined", q: WAFRuleQuery{Message: "xss", Tag: "language-php"}, want: "[]"},
+	} {
+		ids := []int{}
+		for _, r := range cat.Search(&tc.q) {
+			ids = append(ids, r.ModSecID)
+		}
+		if got := fmt.Sprint(ids); got != tc.want {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.want, got)
+		}
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+	if err := cat.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("expected Refresh to bypass the cache, got %d calls", n)
+	}
+}
@@ -1,9 +1,7 @@
 package fastly
 
 import (
-	"bytes"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"strings"
@@ -161,27 +159,15 @@ func (c *Client) ListWAFRuleExclusions(i *ListWAFRuleExclusionsInput) (*WAFRuleE
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
+	info, body, err := getResponseInfo(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFRuleExclusionType)
+	wafExclusions, err := decodeMany[WAFRuleExclusion](body)
 	if err != nil {
 		return nil, err
 	}
-
-	wafExclusions := make([]*WAFRuleExclusion, len(data))
-	for i := range data {
-		typed, ok := data[i].(*WAFRuleExclusion)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-WAFRuleExclusion response")
-		}
-		wafExclusions[i] = typed
-	}
 	return &WAFRuleExclusionResponse{
 		Items: wafExclusions,
 		Info:  info,
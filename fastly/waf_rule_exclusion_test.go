@@ -345,7 +345,7 @@ func createWAFWithRulesForExclusion(t *testing.T, fixtureBase string, testServic
 	return waf
 }
 
-func buildWAFRulesForExclusion(status string) []*WAFActiveRule {
+func buildWAFRulesForExclusion(status WAFActiveRuleStatus) []*WAFActiveRule {
 
 	return []*WAFActiveRule{
 		{
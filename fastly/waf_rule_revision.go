@@ -0,0 +1,163 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/jsonapi"
+)
+
+// WAFRuleRevisionType is used for reflection because JSONAPI wants to know what it's
+// decoding into.
+var WAFRuleRevisionType = reflect.TypeOf(new(WAFRuleRevision))
+
+// WAFRuleRevisionResponse represents a list WAF rule revisions full response.
+type WAFRuleRevisionResponse struct {
+	Items []*WAFRuleRevision
+	Info  infoResponse
+}
+
+// ListWAFRuleRevisionsInput used as input for listing the revisions of a WAF rule.
+type ListWAFRuleRevisionsInput struct {
+	// The WAF rule's ID.
+	WAFRuleID string
+	// Limit the number of returned revisions.
+	PageSize int
+	// Request a specific page of revisions.
+	PageNumber int
+	// Include relationships. Optional, comma-separated values. Permitted values: waf_rule.
+	Include string
+}
+
+func (i *ListWAFRuleRevisionsInput) formatFilters() map[string]string {
+
+	result := map[string]string{}
+	pairings := map[string]interface{}{
+		"page[size]":   i.PageSize,
+		"page[number]": i.PageNumber,
+		"include":      i.Include,
+	}
+
+	for key, value := range pairings {
+		switch t := reflect.TypeOf(value).String(); t {
+		case "string":
+			if value != "" {
+				result[key] = value.(string)
+			}
+		case "int":
+			if value != 0 {
+				result[key] = strconv.Itoa(value.(int))
+			}
+		}
+	}
+	return result
+}
+
+// ListWAFRuleRevisions returns the list of revisions for a given WAF rule ID.
+func (c *Client) ListWAFRuleRevisions(i *ListWAFRuleRevisionsInput) (*WAFRuleRevisionResponse, error) {
+
+	if i.WAFRuleID == "" {
+		return nil, ErrMissingWAFRuleID
+	}
+
+	path := fmt.Sprintf("/waf/rules/%s/revisions", i.WAFRuleID)
+	resp, err := c.Get(path, &RequestOptions{
+		Params: i.formatFilters(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, body, err := getResponseInfo(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := decodeMany[WAFRuleRevision](body)
+	if err != nil {
+		return nil, err
+	}
+	return &WAFRuleRevisionResponse{
+		Items: revisions,
+		Info:  info,
+	}, nil
+}
+
+// ListAllWAFRuleRevisionsInput used as input for listing all the revisions of a WAF rule.
+type ListAllWAFRuleRevisionsInput struct {
+	// The WAF rule's ID.
+	WAFRuleID string
+	// Include relationships. Optional, comma-separated values. Permitted values: waf_rule.
+	Include string
+}
+
+// ListAllWAFRuleRevisions returns the complete list of revisions for a given WAF rule ID. It iterates through
+// all existing pages to ensure all revisions are returned at once.
+func (c *Client) ListAllWAFRuleRevisions(i *ListAllWAFRuleRevisionsInput) (*WAFRuleRevisionResponse, error) {
+
+	if i.WAFRuleID == "" {
+		return nil, ErrMissingWAFRuleID
+	}
+
+	currentPage := 1
+	result := &WAFRuleRevisionResponse{Items: []*WAFRuleRevision{}}
+	for {
+		r, err := c.ListWAFRuleRevisions(&ListWAFRuleRevisionsInput{
+			WAFRuleID:  i.WAFRuleID,
+			Include:    i.Include,
+			PageNumber: currentPage,
+			PageSize:   WAFPaginationPageSize,
+		})
+		if err != nil {
+			return r, err
+		}
+
+		currentPage++
+		result.Items = append(result.Items, r.Items...)
+
+		if r.Info.Links.Next == "" || len(r.Items) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// GetWAFRuleRevisionInput used as input for GetWAFRuleRevision function.
+type GetWAFRuleRevisionInput struct {
+	// The WAF rule's ID.
+	WAFRuleID string
+	// The WAF rule's revision number.
+	WAFRuleRevisionNumber int
+	// Include relationships. Optional, comma-separated values. Permitted values: waf_rule, source and vcl.
+	Include string
+}
+
+// GetWAFRuleRevision gets details for a specific revision of a WAF rule,
+// including its source and VCL when requested through Include.
+func (c *Client) GetWAFRuleRevision(i *GetWAFRuleRevisionInput) (*WAFRuleRevision, error) {
+
+	if i.WAFRuleID == "" {
+		return nil, ErrMissingWAFRuleID
+	}
+
+	if i.WAFRuleRevisionNumber == 0 {
+		return nil, ErrMissingWAFRuleRevisionNumber
+	}
+
+	ro := &RequestOptions{}
+	if i.Include != "" {
+		ro.Params = map[string]string{"include": i.Include}
+	}
+
+	path := fmt.Sprintf("/waf/rules/%s/revisions/%d", i.WAFRuleID, i.WAFRuleRevisionNumber)
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var revision WAFRuleRevision
+	if err := jsonapi.UnmarshalPayload(resp.Body, &revision); err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
@@ -0,0 +1,133 @@
+package fastly
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_WAF_Rule_Revisions(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "waf_rule_revisions/"
+	ruleID := "3eSHroY1kb4wdIG7Btx2XQ"
+
+	var err error
+	var revisionsResp *WAFRuleRevisionResponse
+	record(t, fixtureBase+"list", func(c *Client) {
+		revisionsResp, err = c.ListWAFRuleRevisions(&ListWAFRuleRevisionsInput{
+			WAFRuleID: ruleID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisionsResp.Items) != 2 {
+		t.Errorf("expected 2 revisions: got %d", len(revisionsResp.Items))
+	}
+	for _, r := range revisionsResp.Items {
+		if r.ModSecID != 921200 {
+			t.Errorf("bad modsec rule ID: %d", r.ModSecID)
+		}
+	}
+
+	record(t, fixtureBase+"list_all", func(c *Client) {
+		revisionsResp, err = c.ListAllWAFRuleRevisions(&ListAllWAFRuleRevisionsInput{
+			WAFRuleID: ruleID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisionsResp.Items) != 2 {
+		t.Errorf("expected 2 revisions: got %d", len(revisionsResp.Items))
+	}
+
+	var revision *WAFRuleRevision
+	record(t, fixtureBase+"get", func(c *Client) {
+		revision, err = c.GetWAFRuleRevision(&GetWAFRuleRevisionInput{
+			WAFRuleID:             ruleID,
+			WAFRuleRevisionNumber: 2,
+			Include:               "source,vcl",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Revision != 2 {
+		t.Errorf("bad revision: %d", revision.Revision)
+	}
+	if revision.State != "latest" {
+		t.Errorf("bad state: %s", revision.State)
+	}
+	if !strings.Contains(revision.Source, "id:921200") {
+		t.Errorf("bad source: %s", revision.Source)
+	}
+	if !strings.Contains(revision.VCL, "waf.anomaly_score") {
+		t.Errorf("bad vcl: %s", revision.VCL)
+	}
+}
+
+func TestClient_ListWAFRuleRevisions_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListWAFRuleRevisions(&ListWAFRuleRevisionsInput{
+		WAFRuleID: "",
+	})
+	if err != ErrMissingWAFRuleID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListAllWAFRuleRevisions_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListAllWAFRuleRevisions(&ListAllWAFRuleRevisionsInput{
+		WAFRuleID: "",
+	})
+	if err != ErrMissingWAFRuleID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetWAFRuleRevision_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetWAFRuleRevision(&GetWAFRuleRevisionInput{
+		WAFRuleID: "",
+	})
+	if err != ErrMissingWAFRuleID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetWAFRuleRevision(&GetWAFRuleRevisionInput{
+		WAFRuleID:             "1",
+		WAFRuleRevisionNumber: 0,
+	})
+	if err != ErrMissingWAFRuleRevisionNumber {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_listWAFRuleRevisions_formatFilters(t *testing.T) {
+	cases := []struct {
+		remote *ListWAFRuleRevisionsInput
+		local  map[string]string
+	}{
+		{
+			remote: &ListWAFRuleRevisionsInput{
+				PageSize:   2,
+				PageNumber: 2,
+				Include:    "waf_rule",
+			},
+			local: map[string]string{
+				"page[size]":   "2",
+				"page[number]": "2",
+				"include":      "waf_rule",
+			},
+		},
+	}
+	for _, c := range cases {
+		out := c.remote.formatFilters()
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\n     got: %#v", c.local, out)
+		}
+	}
+}
@@ -1,14 +1,10 @@
 package fastly
 
 import (
-	"bytes"
-	"fmt"
-	"io"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
-
-	"github.com/google/jsonapi"
 )
 
 // WAFRuleType is used for reflection because JSONAPI wants to know what it's
@@ -22,6 +18,27 @@ type WAFRule struct {
 	Publisher string             `jsonapi:"attr,publisher,omitempty"`
 	Type      string             `jsonapi:"attr,type,omitempty"`
 	Revisions []*WAFRuleRevision `jsonapi:"relation,waf_rule_revisions,omitempty"`
+	Tags      []*WAFTag          `jsonapi:"relation,waf_tags,omitempty"`
+}
+
+// WAFTag is the information about a WAF tag object, which groups rules, e.g.
+// by the attack or the technology they apply to.
+type WAFTag struct {
+	ID   string `jsonapi:"primary,waf_tag,omitempty"`
+	Name string `jsonapi:"attr,name,omitempty"`
+}
+
+// LatestRevision returns the revision of the rule with the highest revision
+// number, or nil if the revisions were not requested (see the Include field of
+// ListWAFRulesInput) or the rule has none.
+func (r *WAFRule) LatestRevision() *WAFRuleRevision {
+	var latest *WAFRuleRevision
+	for _, rev := range r.Revisions {
+		if latest == nil || rev.Revision > latest.Revision {
+			latest = rev
+		}
+	}
+	return latest
 }
 
 // WAFRuleRevision is the information about a WAF rule revision object.
@@ -52,7 +69,10 @@ type ListWAFRulesInput struct {
 	// Limit the returned rules to a set by modsecurity rule IDs.
 	FilterModSecIDs []int
 	// Excludes individual rules by modsecurity rule IDs.
-	// TODO: fix typo ExcludeMocSecIDs -> ExcludeModSecIDs
+	ExcludeModSecIDs []int
+	// Excludes individual rules by modsecurity rule IDs.
+	//
+	// Deprecated: use ExcludeModSecIDs instead.
 	ExcludeMocSecIDs []int
 	// Limit the number of returned rules.
 	PageSize int
@@ -69,7 +89,7 @@ func (i *ListWAFRulesInput) formatFilters() map[string]string {
 		"filter[waf_tags][name][in]":  i.FilterTagNames,
 		"filter[publisher][in]":       i.FilterPublishers,
 		"filter[modsec_rule_id][in]":  i.FilterModSecIDs,
-		"filter[modsec_rule_id][not]": i.ExcludeMocSecIDs,
+		"filter[modsec_rule_id][not]": append(append([]int{}, i.ExcludeModSecIDs...), i.ExcludeMocSecIDs...),
 		"page[size]":                  i.PageSize,
 		"page[number]":                i.PageNumber,
 		"include":                     i.Include,
@@ -113,27 +133,15 @@ func (c *Client) ListWAFRules(i *ListWAFRulesInput) (*WAFRuleResponse, error) {
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
+	info, body, err := getResponseInfo(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFRuleType)
+	wafRules, err := decodeMany[WAFRule](body)
 	if err != nil {
 		return nil, err
 	}
-
-	wafRules := make([]*WAFRule, len(data))
-	for i := range data {
-		typed, ok := data[i].(*WAFRule)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-WAFRule response")
-		}
-		wafRules[i] = typed
-	}
 	return &WAFRuleResponse{
 		Items: wafRules,
 		Info:  info,
@@ -149,6 +157,10 @@ type ListAllWAFRulesInput struct {
 	// Limit the returned rules to a set by modsecurity rule IDs.
 	FilterModSecIDs []int
 	// Excludes individual rules by modsecurity rule IDs.
+	ExcludeModSecIDs []int
+	// Excludes individual rules by modsecurity rule IDs.
+	//
+	// Deprecated: use ExcludeModSecIDs instead.
 	ExcludeMocSecIDs []int
 	// Include relationships. Optional, comma-separated values. Permitted values: waf_tags and waf_rule_revisions.
 	Include string
@@ -156,29 +168,77 @@ type ListAllWAFRulesInput struct {
 
 // ListAllWAFRules returns the complete list of WAF rules for the given filters. It iterates through
 // all existing pages to ensure all WAF rules are returned at once.
+//
+// The list is cached per set of filters when the client was created with
+// WithCache. The rules themselves are then shared between calls and must not
+// be modified.
 func (c *Client) ListAllWAFRules(i *ListAllWAFRulesInput) (*WAFRuleResponse, error) {
+	filters := ListWAFRulesInput{
+		FilterTagNames:   i.FilterTagNames,
+		FilterPublishers: i.FilterPublishers,
+		FilterModSecIDs:  i.FilterModSecIDs,
+		ExcludeModSecIDs: i.ExcludeModSecIDs,
+		ExcludeMocSecIDs: i.ExcludeMocSecIDs,
+		Include:          i.Include,
+		PageSize:         WAFPaginationPageSize,
+	}
+	params := make(url.Values)
+	for k, v := range filters.formatFilters() {
+		params.Set(k, v)
+	}
 
-	currentPage := 1
-	result := &WAFRuleResponse{Items: []*WAFRule{}}
-	for {
+	v, err := c.cache.get("/waf/rules?"+params.Encode(), func() (interface{}, error) {
+		items := []*WAFRule{}
+		for page := 1; ; page++ {
+			filters.PageNumber = page
+			r, err := c.ListWAFRules(&filters)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, r.Items...)
+
+			if r.Info.Links.Next == "" || len(r.Items) == 0 {
+				return items, nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a copy of the list so that changing it does not modify the cache.
+	return &WAFRuleResponse{Items: append([]*WAFRule{}, v.([]*WAFRule)...)}, nil
+}
+
+// StreamWAFRules calls fn for each WAF rule matching the filters, fetching the
+// rules one page at a time instead of collecting the whole catalog like
+// ListAllWAFRules, so memory use stays bounded by the page size. Iteration
+// stops at the first error returned by fn, which is then returned.
+func (c *Client) StreamWAFRules(i *ListAllWAFRulesInput, fn func(*WAFRule) error) error {
+	for page := 1; ; page++ {
 		r, err := c.ListWAFRules(&ListWAFRulesInput{
 			FilterTagNames:   i.FilterTagNames,
 			FilterPublishers: i.FilterPublishers,
 			FilterModSecIDs:  i.FilterModSecIDs,
+			ExcludeModSecIDs: i.ExcludeModSecIDs,
 			ExcludeMocSecIDs: i.ExcludeMocSecIDs,
 			Include:          i.Include,
-			PageNumber:       currentPage,
+			PageNumber:       page,
 			PageSize:         WAFPaginationPageSize,
 		})
 		if err != nil {
-			return r, err
+			return err
 		}
 
-		currentPage++
-		result.Items = append(result.Items, r.Items...)
+		for _, rule := range r.Items {
+			if err := fn(rule); err != nil {
+				return err
+			}
+		}
 
 		if r.Info.Links.Next == "" || len(r.Items) == 0 {
-			return result, nil
+			return nil
 		}
 	}
 }
@@ -52,6 +52,22 @@ func TestClient_WAF_Rules(t *testing.T) {
 	}
 	fastlyRulesNumber = len(rulesResp.Items)
 
+	var streamed int
+	record(t, fixtureBase+"/list_all_fastly", func(c *Client) {
+		err = c.StreamWAFRules(&ListAllWAFRulesInput{
+			FilterPublishers: []string{publisher},
+		}, func(r *WAFRule) error {
+			streamed++
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if streamed != fastlyRulesNumber {
+		t.Errorf("expected %d streamed rules: got %d", fastlyRulesNumber, streamed)
+	}
+
 	record(t, fixtureBase+"/list_all_fastly_exclusion", func(c *Client) {
 		rulesResp, err = c.ListAllWAFRules(&ListAllWAFRulesInput{
 			FilterPublishers: []string{publisher},
@@ -113,6 +129,17 @@ func TestClient_listWAFRules_formatFilters(t *testing.T) {
 				"include":                     "included",
 			},
 		},
+		{
+			remote: &ListWAFRulesInput{
+				FilterPublishers: []string{"owasp"},
+				ExcludeModSecIDs: []int{123456},
+				ExcludeMocSecIDs: []int{1234567},
+			},
+			local: map[string]string{
+				"filter[publisher][in]":       "owasp",
+				"filter[modsec_rule_id][not]": "123456,1234567",
+			},
+		},
 	}
 	for _, c := range cases {
 		out := c.remote.formatFilters()
@@ -121,3 +148,19 @@ func TestClient_listWAFRules_formatFilters(t *testing.T) {
 		}
 	}
 }
+
+func TestWAFRule_LatestRevision(t *testing.T) {
+	r := &WAFRule{}
+	if rev := r.LatestRevision(); rev != nil {
+		t.Errorf("expected no revision, got: %v", rev)
+	}
+
+	r.Revisions = []*WAFRuleRevision{
+		{ID: "1", Revision: 1},
+		{ID: "3", Revision: 3},
+		{ID: "2", Revision: 2},
+	}
+	if rev := r.LatestRevision(); rev == nil || rev.ID != "3" {
+		t.Errorf("bad latest revision: %v", rev)
+	}
+}
@@ -0,0 +1,129 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/google/jsonapi"
+)
+
+// WAFRuleset identifies the ruleset of a WAF, which is updated to deploy the
+// WAF's rule changes.
+type WAFRuleset struct {
+	ID string `jsonapi:"primary,ruleset"`
+}
+
+// WAFUpdateStatus is the status of an update of a WAF's ruleset.
+type WAFUpdateStatus struct {
+	ID          string     `jsonapi:"primary,waf_update_status"`
+	Status      string     `jsonapi:"attr,status,omitempty"`
+	Message     string     `jsonapi:"attr,message,omitempty"`
+	CreatedAt   *time.Time `jsonapi:"attr,created_at,iso8601,omitempty"`
+	UpdatedAt   *time.Time `jsonapi:"attr,updated_at,iso8601,omitempty"`
+	CompletedAt *time.Time `jsonapi:"attr,completed_at,iso8601,omitempty"`
+
+	// Link is the URL of the status, as returned by UpdateWAFRuleSets.
+	Link string
+}
+
+// UpdateWAFRuleSetsInput is used as input to the UpdateWAFRuleSets function.
+type UpdateWAFRuleSetsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// WAFID is the ID of the WAF whose ruleset is updated (required).
+	WAFID string
+}
+
+// UpdateWAFRuleSets deploys the rule changes of a WAF by updating its ruleset.
+// The update runs asynchronously: the returned WAFUpdateStatus only has its ID
+// and Link set, and GetWAFUpdateStatus reports its progress.
+func (c *Client) UpdateWAFRuleSets(i *UpdateWAFRuleSetsInput) (*WAFUpdateStatus, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.WAFID == "" {
+		return nil, ErrMissingWAFID
+	}
+
+	p := fmt.Sprintf("/service/%s/wafs/%s/ruleset", i.ServiceID, i.WAFID)
+	resp, err := c.PatchJSONAPI(p, &WAFRuleset{ID: i.WAFID}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// The status link is returned in the Location header and in the related
+	// link of the body.
+	link := resp.Header.Get("Location")
+	if link == "" {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		var r struct {
+			Links struct {
+				Related struct {
+					Href string `json:"href"`
+				} `json:"related"`
+			} `json:"links"`
+		}
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+		link = r.Links.Related.Href
+	}
+
+	u, err := url.Parse(link)
+	if link == "" || err != nil {
+		return nil, fmt.Errorf("missing or invalid update status link %q in ruleset update response", link)
+	}
+	return &WAFUpdateStatus{ID: path.Base(u.Path), Link: link}, nil
+}
+
+// GetWAFUpdateStatusInput is used as input to the GetWAFUpdateStatus function.
+type GetWAFUpdateStatusInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// WAFID is the ID of the WAF (required).
+	WAFID string
+
+	// ID is the ID of the update status, as returned by UpdateWAFRuleSets
+	// (required).
+	ID string
+}
+
+// GetWAFUpdateStatus returns the status of an update of a WAF's ruleset.
+func (c *Client) GetWAFUpdateStatus(i *GetWAFUpdateStatusInput) (*WAFUpdateStatus, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.WAFID == "" {
+		return nil, ErrMissingWAFID
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	p := fmt.Sprintf("/service/%s/wafs/%s/update_statuses/%s", i.ServiceID, i.WAFID, i.ID)
+	resp, err := c.Get(p, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var status WAFUpdateStatus
+	if err := jsonapi.UnmarshalPayload(resp.Body, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
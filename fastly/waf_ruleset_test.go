@@ -0,0 +1,79 @@
+package fastly
+
+import (
+	"testing"
+)
+
+func TestClient_WAFRuleSets(t *testing.T) {
+	t.Parallel()
+
+	fixtureBase := "waf_rulesets/"
+
+	var err error
+	var status *WAFUpdateStatus
+	record(t, fixtureBase+"update", func(c *Client) {
+		status, err = c.UpdateWAFRuleSets(&UpdateWAFRuleSetsInput{
+			ServiceID: testServiceID,
+			WAFID:     "3Pxlc3CjBd8efnATbW4BzC",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.ID != "7VNwbl7ed3rHwOBRdyfzpO" {
+		t.Errorf("bad update status ID: %q", status.ID)
+	}
+	if status.Link != "https://api.fastly.com/service/"+testServiceID+"/wafs/3Pxlc3CjBd8efnATbW4BzC/update_statuses/7VNwbl7ed3rHwOBRdyfzpO" {
+		t.Errorf("bad update status link: %q", status.Link)
+	}
+
+	record(t, fixtureBase+"update_status", func(c *Client) {
+		status, err = c.GetWAFUpdateStatus(&GetWAFUpdateStatusInput{
+			ServiceID: testServiceID,
+			WAFID:     "3Pxlc3CjBd8efnATbW4BzC",
+			ID:        status.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != "complete" || status.Message != "Ruleset updated" {
+		t.Errorf("bad update status: %#v", status)
+	}
+	if status.CompletedAt == nil {
+		t.Error("expected a completion time")
+	}
+}
+
+func TestClient_UpdateWAFRuleSets_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateWAFRuleSets(&UpdateWAFRuleSetsInput{})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateWAFRuleSets(&UpdateWAFRuleSetsInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingWAFID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetWAFUpdateStatus_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetWAFUpdateStatus(&GetWAFUpdateStatusInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingWAFID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetWAFUpdateStatus(&GetWAFUpdateStatusInput{
+		ServiceID: "foo",
+		WAFID:     "bar",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
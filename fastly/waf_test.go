@@ -1,8 +1,12 @@
 package fastly
 
 import (
+	"bytes"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/google/jsonapi"
 )
 
 func TestClient_WAFs(t *testing.T) {
@@ -56,6 +60,21 @@ func TestClient_WAFs(t *testing.T) {
 		t.Errorf("bad wafs: %v", wafsResp.Items)
 	}
 
+	// List all
+	var allWAFsResp *WAFResponse
+	record(t, fixtureBase+"/list_all", func(c *Client) {
+		allWAFsResp, err = c.ListAllWAFs(&ListAllWAFsInput{
+			FilterService: testService.ID,
+			FilterVersion: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allWAFsResp.Items) != len(wafsResp.Items) {
+		t.Errorf("bad wafs: %v", allWAFsResp.Items)
+	}
+
 	// Ensure deleted
 	defer func() {
 		record(t, fixtureBase+"/cleanup", func(c *Client) {
@@ -302,3 +321,22 @@ func TestClient_listWAFs_formatFilters(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateWAFInput_clearPrefetchCondition(t *testing.T) {
+	var buf bytes.Buffer
+	err := jsonapi.MarshalPayload(&buf, &UpdateWAFInput{
+		ID:                "1",
+		ServiceID:         ToPointer("foo"),
+		ServiceVersion:    ToPointer(1),
+		PrefetchCondition: ToPointer(""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"prefetch_condition":""`) {
+		t.Errorf("expected empty prefetch_condition to be sent: %s", buf.String())
+	}
+	if strings.Contains(buf.String(), `"response"`) {
+		t.Errorf("expected unset response to be omitted: %s", buf.String())
+	}
+}
@@ -1,9 +1,7 @@
 package fastly
 
 import (
-	"bytes"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"time"
@@ -142,27 +140,15 @@ func (c *Client) ListWAFVersions(i *ListWAFVersionsInput) (*WAFVersionResponse,
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
+	info, body, err := getResponseInfo(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFVersionType)
+	wafVersions, err := decodeMany[WAFVersion](body)
 	if err != nil {
 		return nil, err
 	}
-
-	wafVersions := make([]*WAFVersion, len(data))
-	for i := range data {
-		typed, ok := data[i].(*WAFVersion)
-		if !ok {
-			return nil, fmt.Errorf("got back a non-WAFVersion response")
-		}
-		wafVersions[i] = typed
-	}
 	return &WAFVersionResponse{
 		Items: wafVersions,
 		Info:  info,
@@ -5,13 +5,22 @@ require (
 	github.com/google/go-querystring v1.1.0
 	github.com/google/jsonapi v1.0.0
 	github.com/hashicorp/go-cleanhttp v0.5.2
-	github.com/kr/pretty v0.1.0 // indirect
 	github.com/mitchellh/mapstructure v1.4.3
 	github.com/peterhellberg/link v1.1.0
-	golang.org/x/tools v0.1.8
+	golang.org/x/crypto v0.8.0
+	golang.org/x/tools v0.7.0
+	honnef.co/go/tools v0.3.3
+)
+
+require (
+	github.com/BurntSushi/toml v0.4.1 // indirect
+	github.com/kr/pretty v0.1.0 // indirect
+	golang.org/x/exp/typeparams v0.0.0-20221208152030-732eee02a75a // indirect
+	golang.org/x/mod v0.10.0 // indirect
+	golang.org/x/sys v0.7.0 // indirect
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	honnef.co/go/tools v0.2.2
 )
 
-go 1.16
+go 1.18
@@ -0,0 +1,14 @@
+#!/usr/bin/env bash
+
+echo "==> Checking that generated files are up to date..."
+
+go generate ./fastly/...
+changed=$(git diff --name-only -- fastly; git ls-files --others --exclude-standard -- fastly)
+if [[ ${changed} ]]; then
+    echo 'Generated files are out of date.'
+    echo " ===== "
+    echo "${changed}"
+    echo " ===== "
+    echo "You can use the command: \`make generate\` to update them."
+    exit 1
+fi
@@ -0,0 +1,112 @@
+// Command fastly-gen generates the types and CRUD methods of a simple,
+// form-encoded service version resource from Fastly's OpenAPI specification.
+//
+// It is run with go generate from the fastly package (see fastly/generate.go),
+// e.g.
+//
+//	go run ../tools/fastly-gen -spec openapi/gzip.json -path /service/{service_id}/version/{version_id}/gzip -type Gzip -out gzip_gen.go
+//
+// where the spec is a path or URL of the specification in JSON format. The
+// collection path must contain the {service_id} and {version_id} parameters,
+// and the item path is the collection path followed by one more parameter.
+// Operations missing from the specification are not generated.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+func main() {
+	var (
+		specPath = flag.String("spec", "", "path or URL of the OpenAPI specification (JSON)")
+		path     = flag.String("path", "", "collection path of the resource, e.g. /service/{service_id}/version/{version_id}/gzip")
+		typeName = flag.String("type", "", "Go type name of the resource, e.g. Gzip")
+		plural   = flag.String("plural", "", "plural of the type name (default: type name + \"s\")")
+		pkg      = flag.String("package", "fastly", "package name of the generated file")
+		out      = flag.String("out", "", "output file (default: standard output)")
+	)
+	flag.Parse()
+
+	if *specPath == "" || *path == "" || *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	doc, err := loadSpec(*specPath)
+	if err != nil {
+		fatalf("loading specification: %v", err)
+	}
+
+	r, err := newResource(doc, *pkg, *path, *typeName, *plural)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	src, err := r.generate()
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func fatalf(format string, v ...interface{}) {
+	fmt.Fprintf(os.Stderr, "fastly-gen: "+format+"\n", v...)
+	os.Exit(1)
+}
+
+// loadSpec reads and decodes the specification from a file or URL.
+func loadSpec(path string) (*document, error) {
+	var rc io.ReadCloser
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		resp, err := http.Get(path)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("GET %s: %s", path, resp.Status)
+		}
+		rc = resp.Body
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		rc = f
+	}
+	defer rc.Close()
+
+	var doc document
+	if err := json.NewDecoder(rc).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// generate renders and formats the Go source of the resource.
+func (r *resource) generate() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, r); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	doc, err := loadSpec("testdata/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := newResource(doc, "fastly", "/service/{service_id}/version/{version_id}/widget", "Widget", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := r.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const golden = "testdata/widget.go.golden"
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated code does not match %s; run go test -update to update it\n%s", golden, got)
+	}
+}
+
+func TestNewResource_badPath(t *testing.T) {
+	doc, err := loadSpec("testdata/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := newResource(doc, "fastly", "/service/{service_id}/widget", "Widget", ""); err == nil {
+		t.Error("expected an error for a path without {version_id}")
+	}
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// field is a field of a generated struct.
+type field struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+// resource describes the generated code of one resource.
+type resource struct {
+	Package string
+	Type    string
+	Plural  string
+
+	// CollectionPath and ItemPath are fmt format strings of the paths.
+	CollectionPath string
+	ItemPath       string
+
+	// Key is the input field identifying an item, "Name" or "ID", and
+	// KeyErr the error returned when it is missing.
+	Key    string
+	KeyErr string
+
+	Fields       []field
+	CreateFields []field
+	UpdateFields []field
+
+	List, Create, Get, Update, Delete bool
+}
+
+// SortByName reports whether the resource has a Name to sort lists by.
+func (r *resource) SortByName() bool {
+	for _, f := range r.Fields {
+		if f.Name == "Name" {
+			return true
+		}
+	}
+	return false
+}
+
+// UsesTime reports whether any field is a *time.Time.
+func (r *resource) UsesTime() bool {
+	for _, f := range r.Fields {
+		if f.Type == "*time.Time" {
+			return true
+		}
+	}
+	return false
+}
+
+// Var is the name of the local variable holding a decoded item.
+func (r *resource) Var() string {
+	return lowerFirst(r.Type)
+}
+
+// SortType is the name of the type sorting a list by name.
+func (r *resource) SortType() string {
+	return lowerFirst(r.Plural) + "ByName"
+}
+
+var paramRe = regexp.MustCompile(`\{([^}]+)\}`)
+
+// newResource collects the operations and schemas of the resource at path.
+func newResource(doc *document, pkg, path, typeName, plural string) (*resource, error) {
+	if plural == "" {
+		plural = typeName + "s"
+	}
+	r := &resource{Package: pkg, Type: typeName, Plural: plural}
+
+	params := paramRe.FindAllStringSubmatch(path, -1)
+	if len(params) != 2 || params[0][1] != "service_id" || params[1][1] != "version_id" {
+		return nil, fmt.Errorf("%s: expected a path with the {service_id} and {version_id} parameters", path)
+	}
+	r.CollectionPath = paramRe.ReplaceAllStringFunc(path, func(p string) string {
+		if p == "{version_id}" {
+			return "%d"
+		}
+		return "%s"
+	})
+
+	itemPath := ""
+	for p := range doc.Paths {
+		rest := strings.TrimPrefix(p, path+"/")
+		if rest != p && strings.HasPrefix(rest, "{") && strings.HasSuffix(rest, "}") && !strings.Contains(rest, "/") {
+			itemPath = p
+			r.ItemPath = r.CollectionPath + "/%s"
+			r.Key, r.KeyErr = "ID", "ErrMissingID"
+			if strings.HasSuffix(rest, "_name}") {
+				r.Key, r.KeyErr = "Name", "ErrMissingName"
+			}
+			break
+		}
+	}
+
+	list, err := doc.operation(path, "get")
+	if err != nil {
+		return nil, err
+	}
+	create, err := doc.operation(path, "post")
+	if err != nil {
+		return nil, err
+	}
+	var get, update, del *operation
+	if itemPath != "" {
+		if get, err = doc.operation(itemPath, "get"); err != nil {
+			return nil, err
+		}
+		if update, err = doc.operation(itemPath, "put"); err != nil {
+			return nil, err
+		}
+		if del, err = doc.operation(itemPath, "delete"); err != nil {
+			return nil, err
+		}
+	}
+	r.List, r.Create, r.Get, r.Update, r.Delete = list != nil, create != nil, get != nil, update != nil, del != nil
+
+	// The fields of the type come from the response of the first read
+	// operation found.
+	var out *schema
+	for _, op := range []*operation{get, list, create} {
+		if out = responseSchema(op); out != nil {
+			break
+		}
+	}
+	if out == nil {
+		return nil, fmt.Errorf("%s: no JSON response schema found", path)
+	}
+	props, err := doc.properties(out)
+	if err != nil {
+		return nil, err
+	}
+	r.Fields = responseFields(props)
+
+	if s := formSchema(create); s != nil {
+		props, err := doc.properties(s)
+		if err != nil {
+			return nil, err
+		}
+		r.CreateFields = inputFields(props, false, "")
+	}
+	if s := formSchema(update); s != nil {
+		props, err := doc.properties(s)
+		if err != nil {
+			return nil, err
+		}
+		r.UpdateFields = inputFields(props, true, r.Key)
+	}
+
+	return r, nil
+}
+
+// responseFields returns the fields of the resource type. The service ID and
+// version come first, as in the hand-written resources.
+func responseFields(props []property) []field {
+	fields := []field{}
+	var rest []field
+	for _, p := range props {
+		switch p.Name {
+		case "service_id":
+			fields = append(fields, field{"ServiceID", "string", `mapstructure:"service_id"`})
+			continue
+		case "version":
+			fields = append(fields, field{"ServiceVersion", "int", `mapstructure:"version"`})
+			continue
+		}
+		typ := goType(p.Schema, true)
+		if typ == "" {
+			continue
+		}
+		rest = append(rest, field{goName(p.Name), typ, fmt.Sprintf(`mapstructure:%q`, p.Name)})
+	}
+	return append(fields, rest...)
+}
+
+// inputFields returns the form fields of a create or update input. Scalar
+// update fields are pointers, so that only the fields that are set are sent,
+// and a "name" field of a resource keyed by name becomes NewName.
+func inputFields(props []property, update bool, key string) []field {
+	var fields []field
+	for _, p := range props {
+		if p.Schema.ReadOnly || p.Name == "service_id" || p.Name == "version" {
+			continue
+		}
+		typ := goType(p.Schema, false)
+		if typ == "" {
+			continue
+		}
+		name := goName(p.Name)
+		if update && key == "Name" && p.Name == "name" {
+			name = "NewName"
+		}
+		opts := ",omitempty"
+		if strings.HasPrefix(typ, "[]") {
+			opts += ",brackets"
+		}
+		if (update && !strings.HasPrefix(typ, "[]")) || typ == "bool" {
+			typ = "*" + typ
+		}
+		fields = append(fields, field{name, typ, fmt.Sprintf(`url:"%s%s"`, p.Name, opts)})
+	}
+	return fields
+}
+
+// goType returns the Go type of a schema, or "" if it is not supported.
+func goType(s *schema, response bool) string {
+	switch s.Type {
+	case "string":
+		if response && s.Format == "date-time" {
+			return "*time.Time"
+		}
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if s.Items != nil && s.Items.Type == "string" {
+			return "[]string"
+		}
+	}
+	return ""
+}
+
+// initialisms are written in upper case in Go names.
+var initialisms = map[string]bool{
+	"acl": true, "api": true, "ca": true, "cpu": true, "dns": true,
+	"http": true, "https": true, "id": true, "ip": true, "json": true,
+	"sni": true, "ssl": true, "tls": true, "ttl": true, "uri": true,
+	"url": true, "vcl": true, "waf": true,
+}
+
+// goName converts a snake_case API name to a Go name.
+func goName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		if initialisms[part] {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// document is the subset of an OpenAPI 3 document used by the generator.
+type document struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// operation is an OpenAPI operation object.
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	RequestBody *body                `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type body struct {
+	Content map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Content map[string]*mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+// schema is an OpenAPI schema object.
+type schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Format      string             `json:"format"`
+	Description string             `json:"description"`
+	Properties  map[string]*schema `json:"properties"`
+	AllOf       []*schema          `json:"allOf"`
+	Items       *schema            `json:"items"`
+	ReadOnly    bool               `json:"readOnly"`
+}
+
+// operation returns the operation for the given method of a path, or nil.
+func (d *document) operation(path, method string) (*operation, error) {
+	raw, ok := d.Paths[path][method]
+	if !ok {
+		return nil, nil
+	}
+	var op operation
+	if err := json.Unmarshal(raw, &op); err != nil {
+		return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+	}
+	return &op, nil
+}
+
+// resolve follows $ref pointers into the components of the document.
+func (d *document) resolve(s *schema) (*schema, error) {
+	for s != nil && s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+		next, ok := d.Components.Schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("unresolved schema reference %q", s.Ref)
+		}
+		s = next
+	}
+	return s, nil
+}
+
+// property is a flattened property of an object schema.
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// properties returns the properties of an object schema, including those of
+// allOf members, sorted by name.
+func (d *document) properties(s *schema) ([]property, error) {
+	seen := make(map[string]*schema)
+	var walk func(s *schema) error
+	walk = func(s *schema) error {
+		s, err := d.resolve(s)
+		if err != nil || s == nil {
+			return err
+		}
+		for _, member := range s.AllOf {
+			if err := walk(member); err != nil {
+				return err
+			}
+		}
+		for name, p := range s.Properties {
+			p, err := d.resolve(p)
+			if err != nil {
+				return err
+			}
+			seen[name] = p
+		}
+		return nil
+	}
+	if err := walk(s); err != nil {
+		return nil, err
+	}
+
+	props := make([]property, 0, len(seen))
+	for name, s := range seen {
+		props = append(props, property{Name: name, Schema: s})
+	}
+	sort.Slice(props, func(i, j int) bool { return props[i].Name < props[j].Name })
+	return props, nil
+}
+
+// formSchema returns the form-encoded request body schema of op, or nil.
+func formSchema(op *operation) *schema {
+	if op == nil || op.RequestBody == nil {
+		return nil
+	}
+	if mt, ok := op.RequestBody.Content["application/x-www-form-urlencoded"]; ok {
+		return mt.Schema
+	}
+	return nil
+}
+
+// responseSchema returns the JSON schema of the successful response of op,
+// unwrapping arrays, or nil.
+func responseSchema(op *operation) *schema {
+	if op == nil {
+		return nil
+	}
+	r, ok := op.Responses["200"]
+	if !ok || r == nil {
+		return nil
+	}
+	mt, ok := r.Content["application/json"]
+	if !ok || mt.Schema == nil {
+		return nil
+	}
+	if mt.Schema.Type == "array" && mt.Schema.Items != nil {
+		return mt.Schema.Items
+	}
+	return mt.Schema
+}
@@ -0,0 +1,217 @@
+package main
+
+import "text/template"
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by fastly-gen from the Fastly OpenAPI specification. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+{{- if or .Get .Update .Delete}}
+	"net/url"
+{{- end}}
+{{- if and .List .SortByName}}
+	"sort"
+{{- end}}
+{{- if .UsesTime}}
+	"time"
+{{- end}}
+)
+
+// {{.Type}} represents a {{.Type}} response from the Fastly API.
+type {{.Type}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+}
+{{- if and .List .SortByName}}
+
+// {{.SortType}} is a sortable list of {{.Plural}}.
+type {{.SortType}} []*{{.Type}}
+
+// Len, Swap, and Less implement the sortable interface.
+func (s {{.SortType}}) Len() int      { return len(s) }
+func (s {{.SortType}}) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s {{.SortType}}) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+{{- end}}
+{{- if .List}}
+
+// List{{.Plural}}Input is used as input to the List{{.Plural}} function.
+type List{{.Plural}}Input struct {
+	{{template "version" .}}
+}
+
+// List{{.Plural}} returns the list of {{.Plural}} for the configuration version.
+func (c *Client) List{{.Plural}}(i *List{{.Plural}}Input) ([]*{{.Type}}, error) {
+	{{template "checks" .}}
+
+	path := fmt.Sprintf("{{.CollectionPath}}", i.ServiceID, i.ServiceVersion)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*{{.Type}}
+	if err := decodeBodyMap(resp.Body, &out); err != nil {
+		return nil, err
+	}
+{{- if .SortByName}}
+	sort.Stable({{.SortType}}(out))
+{{- end}}
+	return out, nil
+}
+{{- end}}
+{{- if .Create}}
+
+// Create{{.Type}}Input is used as input to the Create{{.Type}} function.
+type Create{{.Type}}Input struct {
+	{{template "version" .}}
+{{if .CreateFields}}
+{{range .CreateFields}}
+	{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+{{- end}}
+}
+
+// Create{{.Type}} creates a new {{.Type}}.
+func (c *Client) Create{{.Type}}(i *Create{{.Type}}Input) (*{{.Type}}, error) {
+	{{template "checks" .}}
+
+	path := fmt.Sprintf("{{.CollectionPath}}", i.ServiceID, i.ServiceVersion)
+	resp, err := c.PostForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var {{.Var}} *{{.Type}}
+	if err := decodeBodyMap(resp.Body, &{{.Var}}); err != nil {
+		return nil, err
+	}
+	return {{.Var}}, nil
+}
+{{- end}}
+{{- if .Get}}
+
+// Get{{.Type}}Input is used as input to the Get{{.Type}} function.
+type Get{{.Type}}Input struct {
+	{{template "version" .}}
+
+	// {{.Key}} identifies the {{.Type}} to fetch (required).
+	{{.Key}} string
+}
+
+// Get{{.Type}} gets the {{.Type}} with the given parameters.
+func (c *Client) Get{{.Type}}(i *Get{{.Type}}Input) (*{{.Type}}, error) {
+	{{template "checks" .}}
+
+	{{template "keycheck" .}}
+
+	path := fmt.Sprintf("{{.ItemPath}}", i.ServiceID, i.ServiceVersion, url.PathEscape(i.{{.Key}}))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var {{.Var}} *{{.Type}}
+	if err := decodeBodyMap(resp.Body, &{{.Var}}); err != nil {
+		return nil, err
+	}
+	return {{.Var}}, nil
+}
+{{- end}}
+{{- if .Update}}
+
+// Update{{.Type}}Input is used as input to the Update{{.Type}} function.
+type Update{{.Type}}Input struct {
+	{{template "version" .}}
+
+	// {{.Key}} identifies the {{.Type}} to update (required).
+	{{.Key}} string
+{{if .UpdateFields}}
+{{range .UpdateFields}}
+	{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+{{- end}}
+}
+
+// Update{{.Type}} updates a specific {{.Type}}.
+func (c *Client) Update{{.Type}}(i *Update{{.Type}}Input) (*{{.Type}}, error) {
+	{{template "checks" .}}
+
+	{{template "keycheck" .}}
+
+	path := fmt.Sprintf("{{.ItemPath}}", i.ServiceID, i.ServiceVersion, url.PathEscape(i.{{.Key}}))
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var {{.Var}} *{{.Type}}
+	if err := decodeBodyMap(resp.Body, &{{.Var}}); err != nil {
+		return nil, err
+	}
+	return {{.Var}}, nil
+}
+{{- end}}
+{{- if .Delete}}
+
+// Delete{{.Type}}Input is the input parameter to Delete{{.Type}}.
+type Delete{{.Type}}Input struct {
+	{{template "version" .}}
+
+	// {{.Key}} identifies the {{.Type}} to delete (required).
+	{{.Key}} string
+}
+
+// Delete{{.Type}} deletes the given {{.Type}}.
+func (c *Client) Delete{{.Type}}(i *Delete{{.Type}}Input) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return ErrMissingServiceVersion
+	}
+
+	if i.{{.Key}} == "" {
+		return {{.KeyErr}}
+	}
+
+	path := fmt.Sprintf("{{.ItemPath}}", i.ServiceID, i.ServiceVersion, url.PathEscape(i.{{.Key}}))
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeBodyMap(resp.Body, &r); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return ErrNotOK
+	}
+	return nil
+}
+{{- end}}
+{{define "version"}}// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+{{- end}}
+{{define "checks"}}if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+{{- end}}
+{{define "keycheck"}}if i.{{.Key}} == "" {
+		return nil, {{.KeyErr}}
+	}
+{{- end}}
+`))
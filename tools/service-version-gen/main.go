@@ -0,0 +1,243 @@
+// Command service-version-gen generates the methods of ServiceVersionClient,
+// which wrap the methods of Client whose input has a ServiceID and a
+// ServiceVersion field.
+//
+// It is run with go generate from the fastly package (see fastly/generate.go):
+//
+//	go run ../tools/service-version-gen -out service_version_client_gen.go
+//
+// The package in the current directory is parsed, so that every new resource
+// method of Client gets its wrapper when the file is regenerated.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	var (
+		dir = flag.String("dir", ".", "directory of the fastly package")
+		out = flag.String("out", "", "output file (default: standard output)")
+	)
+	flag.Parse()
+
+	src, err := generate(*dir, filepath.Base(*out))
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func fatalf(format string, v ...interface{}) {
+	fmt.Fprintf(os.Stderr, "service-version-gen: "+format+"\n", v...)
+	os.Exit(1)
+}
+
+// method is a method of Client to wrap.
+type method struct {
+	Name    string
+	Input   string
+	Results string
+}
+
+// generate parses the package in dir, skipping the file named skip (the
+// previous output), and returns the source of the wrappers.
+func generate(dir, skip string) ([]byte, error) {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range pkg.GoFiles {
+		if name == skip {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	// The input types with a ServiceID and a ServiceVersion.
+	versioned := map[string]bool{}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if st, ok := ts.Type.(*ast.StructType); ok && hasField(st, "ServiceID", "string") && hasField(st, "ServiceVersion", "int") {
+				versioned[ts.Name.Name] = true
+			}
+			return false
+		})
+	}
+
+	var methods []method
+	imports := map[string]bool{}
+	for _, f := range files {
+		paths := importPaths(f)
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || !fd.Name.IsExported() || !isClient(fd.Recv) {
+				continue
+			}
+			input := inputType(fd.Type)
+			if !versioned[input] {
+				continue
+			}
+
+			results, used := resultTypes(fset, fd.Type.Results)
+			for _, name := range used {
+				p, ok := paths[name]
+				if !ok {
+					return nil, fmt.Errorf("%s: unknown package %s", fd.Name.Name, name)
+				}
+				imports[p] = true
+			}
+			methods = append(methods, method{Name: fd.Name.Name, Input: input, Results: results})
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by service-version-gen. DO NOT EDIT.\n\npackage %s\n", pkg.Name)
+	if len(imports) > 0 {
+		var list []string
+		for p := range imports {
+			list = append(list, strconv.Quote(p))
+		}
+		sort.Strings(list)
+		if len(list) == 1 {
+			fmt.Fprintf(&buf, "\nimport %s\n", list[0])
+		} else {
+			fmt.Fprintf(&buf, "\nimport (\n%s\n)\n", strings.Join(list, "\n"))
+		}
+	}
+	for _, m := range methods {
+		fmt.Fprintf(&buf, `
+// %[1]s calls Client.%[1]s for the bound service version.
+func (s *ServiceVersionClient) %[1]s(i *%[2]s) %[3]s {
+	in := *i
+	in.ServiceID, in.ServiceVersion = s.ServiceID, s.ServiceVersion
+	return s.client.%[1]s(&in)
+}
+`, m.Name, m.Input, m.Results)
+	}
+	return format.Source(buf.Bytes())
+}
+
+// hasField reports whether st has a field with the given name and type.
+func hasField(st *ast.StructType, name, typ string) bool {
+	for _, f := range st.Fields.List {
+		id, ok := f.Type.(*ast.Ident)
+		if !ok || id.Name != typ {
+			continue
+		}
+		for _, n := range f.Names {
+			if n.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isClient reports whether a receiver is *Client.
+func isClient(recv *ast.FieldList) bool {
+	if len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && id.Name == "Client"
+}
+
+// inputType returns the name of T for a function taking a single *T, or "".
+func inputType(ft *ast.FuncType) string {
+	if ft.TypeParams != nil || len(ft.Params.List) != 1 || len(ft.Params.List[0].Names) > 1 {
+		return ""
+	}
+	star, ok := ft.Params.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	id, ok := star.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return id.Name
+}
+
+// resultTypes returns the result list of a function without names, and the
+// packages it refers to.
+func resultTypes(fset *token.FileSet, results *ast.FieldList) (string, []string) {
+	if results == nil {
+		return "", nil
+	}
+
+	var types, pkgs []string
+	for _, f := range results.List {
+		var buf bytes.Buffer
+		printer.Fprint(&buf, fset, f.Type)
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for ; n > 0; n-- {
+			types = append(types, buf.String())
+		}
+		ast.Inspect(f.Type, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					pkgs = append(pkgs, id.Name)
+				}
+			}
+			return true
+		})
+	}
+	if len(types) == 1 {
+		return types[0], pkgs
+	}
+	return "(" + strings.Join(types, ", ") + ")", pkgs
+}
+
+// importPaths returns the import paths of a file keyed by package name.
+func importPaths(f *ast.File) map[string]string {
+	paths := map[string]string{}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := p[strings.LastIndex(p, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		paths[name] = p
+	}
+	return paths
+}
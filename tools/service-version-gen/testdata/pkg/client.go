@@ -0,0 +1,40 @@
+package fastly
+
+import "io"
+
+type Client struct{}
+
+type ServiceVersionClient struct {
+	client         *Client
+	ServiceID      string
+	ServiceVersion int
+}
+
+type Widget struct{}
+
+type GetWidgetInput struct {
+	ServiceID      string
+	ServiceVersion int
+	Name           string
+}
+
+func (c *Client) GetWidget(i *GetWidgetInput) (*Widget, error) { return nil, nil }
+
+type DownloadWidgetInput struct {
+	ServiceID      string
+	ServiceVersion int
+}
+
+func (c *Client) DownloadWidget(i *DownloadWidgetInput) (rc io.ReadCloser, err error) {
+	return nil, nil
+}
+
+// Methods whose input lacks a ServiceVersion are not wrapped.
+type GetServiceInput struct {
+	ServiceID string
+}
+
+func (c *Client) GetService(i *GetServiceInput) error { return nil }
+
+// Unexported methods are not wrapped.
+func (c *Client) getWidget(i *GetWidgetInput) error { return nil }